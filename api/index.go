@@ -7,38 +7,48 @@ import (
 	"sync"
 
 	"github.com/labstack/echo/v4"
-	"gorm.io/gorm"
 
+	"github.com/theCompanyDream/id-trials/apps/backend/config"
 	"github.com/theCompanyDream/id-trials/apps/backend/controller"
 	_ "github.com/theCompanyDream/id-trials/apps/backend/docs" // docs is generated by Swag CLI, you
 
 	"github.com/theCompanyDream/id-trials/apps/backend/repository"
 )
 
-// Echo instance
+// Echo instance, built by the first request that initializes successfully
 var (
-	server     *echo.Echo
-	echoOnce   sync.Once
-	initDBOnce sync.Once
-	db         *gorm.DB
+	server *echo.Echo
+	initMu sync.Mutex
 )
 
-func RunServer() {
-	server = controller.NewServerlessEchoServer(db)
+// RunServer loads the configuration, connects to the database and builds the
+// Echo instance. A failure leaves nothing behind, so the next request retries.
+func RunServer() (*echo.Echo, error) {
+	initMu.Lock()
+	defer initMu.Unlock()
+	if server != nil {
+		return server, nil
+	}
+
+	cfg, err := config.Load("", nil)
+	if err != nil {
+		return nil, fmt.Errorf("Configuration error: %v", err)
+	}
+	db, err := repository.ServerlessInitDB(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("Database initialization error: %v\n%s", err, debug.Stack())
+	}
+	server = controller.NewServerlessEchoServer(db, cfg)
+	return server, nil
 }
 
 func Handler(w http.ResponseWriter, r *http.Request) {
-	// Initialize the database, capturing errors with full stack trace.
-	initDBOnce.Do(func() {
-		initial_db, err := repository.ServerlessInitDB()
-		if err != nil {
-			http.Error(w, fmt.Sprintf("Database initialization error: %v\n%s", err, debug.Stack()), http.StatusInternalServerError)
-			return
-		}
-		db = initial_db
-	})
-	echoOnce.Do(RunServer)
+	e, err := RunServer()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
 
 	// Pass the request to Echo's HTTP handler.
-	server.ServeHTTP(w, r)
+	e.ServeHTTP(w, r)
 }
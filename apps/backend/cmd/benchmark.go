@@ -0,0 +1,104 @@
+package cmd
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+	"gorm.io/gorm"
+
+	"github.com/theCompanyDream/id-trials/apps/backend/models"
+	"github.com/theCompanyDream/id-trials/apps/backend/repository"
+)
+
+// RunWriteBenchmark inserts a controlled batch into each ID table, one table at a
+// time, and records the WAL, index and buffer deltas the batch produced.
+// Tables are measured sequentially because pg_stat_wal is cluster-wide.
+func RunWriteBenchmark(config *models.CmdConfig, db *gorm.DB) {
+	targets := []struct {
+		idType string
+		table  string
+		fn     func(*gorm.DB, int, int, int, generateOptions) error
+	}{
+		{"ULID", models.UserUlid{}.TableName(), generateULIDData},
+		{"KSUID", models.UserKSUID{}.TableName(), generateKSUIDData},
+		{"UUID", models.UserUUID{}.TableName(), generateUUID4Data},
+		{"Snowflake", models.UserSnowflake{}.TableName(), generateSnowflakeData},
+		{"NanoID", models.UserNanoID{}.TableName(), generateNanoIDData},
+		{"CUID", models.UserCUID{}.TableName(), generateCUIDData},
+	}
+
+	opts := generateOptions{Insert: insertWithBatches, Seed: config.Seed, Retries: config.Retries}
+	if opts.Seed == 0 {
+		opts.Seed = NewSeed()
+	}
+
+	runID := ulid.Make().String()
+	fmt.Printf("Write benchmark %s: inserting %d records per table in batches of %d (seed %d)...\n",
+		runID, config.RecordsPerTable, config.BatchSize, opts.Seed)
+
+	for _, target := range targets {
+		// Pin a single connection so the flush covers the backend that did the writes
+		err := db.Connection(func(conn *gorm.DB) error {
+			benchRepo := repository.NewBenchmarkRepository(conn)
+
+			if err := benchRepo.FlushStats(); err != nil {
+				return err
+			}
+			before, err := benchRepo.Snapshot(target.table)
+			if err != nil {
+				return err
+			}
+
+			start := time.Now()
+			if err := target.fn(conn, config.RecordsPerTable, config.BatchSize, 0, opts); err != nil {
+				return err
+			}
+			duration := time.Since(start)
+
+			if err := benchRepo.FlushStats(); err != nil {
+				return err
+			}
+			after, err := benchRepo.Snapshot(target.table)
+			if err != nil {
+				return err
+			}
+
+			result := &models.WriteBenchmark{
+				RunID:            runID,
+				Seed:             opts.Seed,
+				IDType:           target.idType,
+				Table:            target.table,
+				Records:          int64(config.RecordsPerTable),
+				WalBytes:         after.WalBytes - before.WalBytes,
+				WalRecords:       after.WalRecords - before.WalRecords,
+				WalFpi:           after.WalFpi - before.WalFpi,
+				IndexPagesBefore: before.IndexPages,
+				IndexPagesAfter:  after.IndexPages,
+				IndexBlocksRead:  after.IndexBlocksRead - before.IndexBlocksRead,
+				IndexBlocksHit:   after.IndexBlocksHit - before.IndexBlocksHit,
+				HeapBlocksRead:   after.HeapBlocksRead - before.HeapBlocksRead,
+				HeapBlocksHit:    after.HeapBlocksHit - before.HeapBlocksHit,
+				Duration:         float64(duration.Milliseconds()),
+				Timestamp:        start,
+			}
+
+			if err := benchRepo.SaveWriteBenchmark(result); err != nil {
+				return err
+			}
+
+			fmt.Printf("✅ %s: %.1f WAL bytes/insert, %d full page images, %d index pages added in %v\n",
+				target.idType,
+				float64(result.WalBytes)/float64(result.Records),
+				result.WalFpi,
+				result.IndexPagesAfter-result.IndexPagesBefore,
+				duration,
+			)
+			return nil
+		})
+		if err != nil {
+			log.Fatalf("Failed to benchmark %s: %v", target.idType, err)
+		}
+	}
+}
@@ -0,0 +1,79 @@
+package cmd
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/theCompanyDream/id-trials/apps/backend/repository"
+)
+
+// retryBackoff is the wait before the first retry of a failed batch; each
+// further retry waits twice as long, up to maxRetryBackoff.
+const (
+	retryBackoff    = 500 * time.Millisecond
+	maxRetryBackoff = 30 * time.Second
+)
+
+// retry runs fn until it succeeds, fails with an error that is not transient,
+// or has been retried attempts times.
+func retry(attempts int, backoff time.Duration, fn func() error) error {
+	err := fn()
+	for attempt := 1; attempt <= attempts && repository.IsTransient(err); attempt++ {
+		// Jitter keeps concurrent generators from retrying in lockstep
+		wait := backoff<<(attempt-1) + rand.N(backoff)
+		if wait > maxRetryBackoff || wait <= 0 {
+			wait = maxRetryBackoff
+		}
+		time.Sleep(wait)
+		err = fn()
+	}
+	return err
+}
+
+// inTransaction runs fn in a transaction on a single pinned connection. COPY
+// needs the raw connection, which database/sql hides inside a *sql.Tx, so the
+// transaction is opened with plain BEGIN and COMMIT statements instead.
+func inTransaction(db *gorm.DB, fn func(tx *gorm.DB) error) error {
+	if _, pinned := db.Statement.ConnPool.(*sql.Conn); !pinned {
+		return db.Connection(func(conn *gorm.DB) error {
+			return inTransaction(conn, fn)
+		})
+	}
+
+	tx := db.Session(&gorm.Session{SkipDefaultTransaction: true})
+	if err := tx.Exec("BEGIN").Error; err != nil {
+		return err
+	}
+	if err := fn(tx); err != nil {
+		if rollbackErr := tx.Exec("ROLLBACK").Error; rollbackErr != nil {
+			return errors.Join(err, fmt.Errorf("rollback: %w", rollbackErr))
+		}
+		return err
+	}
+	return tx.Exec("COMMIT").Error
+}
+
+// writeBatch writes one batch of users with write and checkpoints the users
+// completed so far in the same transaction, retrying transient failures.
+func (o generateOptions) writeBatch(db *gorm.DB, completed, users int, write func(tx *gorm.DB) error) error {
+	err := retry(o.Retries, retryBackoff, func() error {
+		return inTransaction(db, func(tx *gorm.DB) error {
+			if err := write(tx); err != nil {
+				return err
+			}
+			if o.Checkpoint == nil {
+				return nil
+			}
+			return o.Checkpoint(tx, completed)
+		})
+	})
+	if err == nil && o.Progress != nil {
+		o.Progress(users)
+	}
+	return err
+}
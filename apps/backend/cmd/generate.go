@@ -1,12 +1,15 @@
 package cmd
 
 import (
+	"errors"
 	"fmt"
 	"log"
+	"math"
+	"os"
+	"strings"
 	"sync"
 	"time"
 
-	"github.com/brianvoe/gofakeit/v6"
 	"github.com/bwmarrin/snowflake"
 	"github.com/google/uuid"
 	gonanoid "github.com/matoous/go-nanoid/v2"
@@ -14,53 +17,259 @@ import (
 	"github.com/oklog/ulid/v2"
 	"github.com/segmentio/ksuid"
 	"gorm.io/gorm"
+	"gorm.io/gorm/schema"
 
 	"github.com/theCompanyDream/id-trials/apps/backend/models"
+	"github.com/theCompanyDream/id-trials/apps/backend/repository"
 )
 
-func GenerateData(config *models.CmdConfig, db *gorm.DB) {
-	var wg sync.WaitGroup
+// inserter writes one batch of generated rows.
+type inserter func(db *gorm.DB, rows interface{}, batchSize int) error
+
+// insertWithBatches writes rows with multi-row INSERTs.
+func insertWithBatches(db *gorm.DB, rows interface{}, batchSize int) error {
+	return db.CreateInBatches(rows, batchSize).Error
+}
 
-	generators := []struct {
-		name string
-		fn   func(*gorm.DB, int, int)
-	}{
-		{"ULID", generateULIDData},
-		{"KSUID", generateKSUIDData},
-		{"UUID4", generateUUID4Data},
-		{"Snowflake", generateSnowflakeData},
-		{"NanoID", generateNanoIDData},
-		{"Kuid", generateKuidData},
-		{"CUID", generateCUIDData},
+// insertWithCopy writes rows with a single COPY FROM STDIN.
+func insertWithCopy(db *gorm.DB, rows interface{}, batchSize int) error {
+	_, err := repository.CopyFrom(db, rows)
+	return err
+}
+
+// rowsPerSecond is the throughput of writing records in duration.
+func rowsPerSecond(records int, duration time.Duration) float64 {
+	if duration <= 0 {
+		return 0
 	}
+	return float64(records) / duration.Seconds()
+}
 
-	fmt.Printf("Generating %d records per table across %d tables concurrently...\n",
-		config.RecordsPerTable, len(generators))
+// generator writes the users, and their orders, of one ID type.
+type generator struct {
+	name   string
+	tag    string // Validator tag of the ID format, accepted by --types like the name
+	users  schema.Tabler
+	orders schema.Tabler
+	fn     func(*gorm.DB, int, int, int, generateOptions) error
+}
 
-	start := time.Now()
+// selectGenerators returns the generators of the ID types named in types, or
+// of every ID type when types is empty.
+func selectGenerators(types []string) ([]generator, error) {
+	return selectIDTypes([]generator{
+		{"ULID", "ulid", models.UserUlid{}, models.OrderUlid{}, generateULIDData},
+		{"KSUID", "ksuid", models.UserKSUID{}, models.OrderKSUID{}, generateKSUIDData},
+		{"UUID", "uuid4", models.UserUUID{}, models.OrderUUID{}, generateUUID4Data},
+		{"Snowflake", "snowflake", models.UserSnowflake{}, models.OrderSnowflake{}, generateSnowflakeData},
+		{"NanoID", "nanoid", models.UserNanoID{}, models.OrderNanoID{}, generateNanoIDData},
+		{"CUID", "cuid2", models.UserCUID{}, models.OrderCUID{}, generateCUIDData},
+	}, types, func(gen generator) (string, string) {
+		return gen.name, gen.tag
+	})
+}
 
+// idTables are the orders and users tables of generators, children first,
+// and the partitioned copies of those users tables.
+func idTables(generators []generator) []interface{} {
+	tables := make([]interface{}, 0, 3*len(generators))
+	for _, gen := range generators {
+		tables = append(tables, gen.orders)
+	}
 	for _, gen := range generators {
+		tables = append(tables, gen.users)
+	}
+	for _, spec := range repository.PartitionSpecs {
+		for _, gen := range generators {
+			if gen.users.TableName() == spec.Source {
+				tables = append(tables, spec.Model)
+			}
+		}
+	}
+	return tables
+}
+
+// GenerateData writes config.RecordsPerTable users, and their orders, to the
+// tables of config.IDTypes, or grows each users table to config.UntilSize
+// bytes. Every batch is checkpointed with its rows, so a run that stops part
+// way can be continued with config.Resume.
+func GenerateData(config *models.CmdConfig, db *gorm.DB) error {
+	benchRepo := repository.NewBenchmarkRepository(db)
+
+	var run *models.GenerationRun
+	completed := map[string]int64{}
+	if config.Resume != "" {
+		if config.Truncate {
+			return errors.New("--truncate cannot be combined with --resume")
+		}
+		resumed, err := benchRepo.UnfinishedGenerationRun(config.Resume)
+		if err != nil {
+			return fmt.Errorf("find the run to resume: %w", err)
+		}
+		if completed, err = benchRepo.GenerationCheckpoints(resumed.RunID); err != nil {
+			return fmt.Errorf("read the checkpoints of run %s: %w", resumed.RunID, err)
+		}
+		run = resumed
+		fmt.Printf("Resuming generation run %s with its own settings; --records, --until-size, --types, --batch, --orders, --copy and --seed are ignored\n", run.RunID)
+	} else {
+		run = &models.GenerationRun{
+			RunID:           ulid.Make().String(),
+			Seed:            config.Seed,
+			Types:           strings.Join(config.IDTypes, ","),
+			RecordsPerTable: int64(config.RecordsPerTable),
+			TargetSize:      config.UntilSize,
+			BatchSize:       int64(config.BatchSize),
+			OrdersPerUser:   int64(config.OrdersPerUser),
+			Method:          "insert",
+			Timestamp:       time.Now(),
+		}
+		if config.UseCopy {
+			run.Method = "copy"
+		}
+		if run.Seed == 0 {
+			run.Seed = NewSeed()
+		}
+		if run.TargetSize > 0 {
+			run.RecordsPerTable = 0
+		}
+	}
+
+	var types []string
+	if run.Types != "" {
+		types = strings.Split(run.Types, ",")
+	}
+	generators, err := selectGenerators(types)
+	if err != nil {
+		return err
+	}
+
+	if config.Truncate {
+		fmt.Printf("Truncating the users and orders tables of %d ID types...\n", len(generators))
+		if err := repository.TruncateTables(db, idTables(generators)...); err != nil {
+			return fmt.Errorf("truncate tables: %w", err)
+		}
+	}
+
+	run.Status = models.GenerationRunning
+	if err := benchRepo.SaveGenerationRun(run); err != nil {
+		return fmt.Errorf("record generation run: %w", err)
+	}
+
+	records, batchSize, ordersPerUser := int(run.RecordsPerTable), int(run.BatchSize), int(run.OrdersPerUser)
+	base := generateOptions{Insert: insertWithBatches, Seed: run.Seed, Retries: config.Retries}
+	if run.Method == "copy" {
+		base.Insert = insertWithCopy
+	}
+
+	if run.TargetSize > 0 {
+		// Batches continue until the table is big enough rather than up to a count
+		records = math.MaxInt
+		fmt.Printf("Generation run %s: growing %d tables to %s each concurrently with %s (seed %d)...\n",
+			run.RunID, len(generators), formatSize(run.TargetSize), run.Method, run.Seed)
+	} else {
+		fmt.Printf("Generation run %s: %d records per table across %d tables concurrently with %s (seed %d)...\n",
+			run.RunID, records, len(generators), run.Method, run.Seed)
+	}
+	if ordersPerUser > 0 {
+		fmt.Printf("Each user receives %d orders\n", ordersPerUser)
+	}
+
+	progress := newProgressDisplay(os.Stdout)
+	tables := make([]*tableProgress, len(generators))
+	for i, gen := range generators {
+		tables[i] = progress.Table(gen.name, records, int(completed[gen.name]))
+		tables[i].targetSize = run.TargetSize
+	}
+
+	var wg sync.WaitGroup
+	start := time.Now()
+	progress.Start(progressInterval)
+
+	for i, gen := range generators {
+		table := tables[i]
+		if table.resumed >= table.total {
+			table.finish(0, nil)
+			continue
+		}
+
+		opts := base
+		opts.Start = int(table.resumed)
+		opts.Checkpoint = func(tx *gorm.DB, completed int) error {
+			return repository.NewBenchmarkRepository(tx).SaveGenerationCheckpoint(run.RunID, gen.name, int64(completed))
+		}
+		opts.Progress = func(users int) {
+			table.written.Add(int64(users))
+		}
+		if run.TargetSize > 0 {
+			opts.Until = func(db *gorm.DB) (bool, error) {
+				size, err := repository.NewBenchmarkRepository(db).TableSize(gen.users.TableName())
+				if err != nil {
+					return false, fmt.Errorf("measure %s: %w", gen.users.TableName(), err)
+				}
+				table.setSize(size)
+				return size >= run.TargetSize, nil
+			}
+		}
+
 		wg.Add(1)
-		go func(name string, genFunc func(*gorm.DB, int, int)) {
+		go func() {
 			defer wg.Done()
 
 			tableStart := time.Now()
-			genFunc(db, config.RecordsPerTable, config.BatchSize)
-			duration := time.Since(tableStart)
-
-			fmt.Printf("✅ %s: Generated %d records in %v\n", name, config.RecordsPerTable, duration)
-		}(gen.name, gen.fn)
+			err := gen.fn(db, records, batchSize, ordersPerUser, opts)
+			table.finish(time.Since(tableStart), err)
+		}()
 	}
 
 	wg.Wait()
+	progress.Stop()
 	totalDuration := time.Since(start)
 
-	fmt.Printf("\n Total: Generated %d records across all tables in %v\n",
-		config.RecordsPerTable*len(generators), totalDuration)
+	var failed []error
+	written := 0
+	for _, table := range tables {
+		written += int(table.written.Load())
+		if table.err != nil {
+			failed = append(failed, fmt.Errorf("%s: %w", table.name, table.err))
+			fmt.Printf("❌ %s: stopped after %d records: %v\n",
+				table.name, table.resumed+table.written.Load(), table.err)
+			continue
+		}
+		fmt.Printf("✅ %s: Generated %d records in %v (%.0f rows/sec)",
+			table.name, table.written.Load(), table.duration, rowsPerSecond(int(table.written.Load()), table.duration))
+		if table.targetSize > 0 {
+			fmt.Printf(", %d in total at %s", table.resumed+table.written.Load(), formatSize(table.size.Load()))
+		}
+		fmt.Println()
+	}
+
+	fmt.Printf("\n Total: Generated %d records across all tables in %v (%.0f rows/sec)\n",
+		written, totalDuration, rowsPerSecond(written, totalDuration))
+
+	run.Duration += float64(totalDuration.Milliseconds())
+	run.Status = models.GenerationCompleted
+	if len(failed) > 0 {
+		run.Status = models.GenerationFailed
+	}
+	if err := benchRepo.SaveGenerationRun(run); err != nil {
+		log.Printf("Warning: failed to record generation run: %v", err)
+	}
+
+	if len(failed) > 0 {
+		return fmt.Errorf("generation run %s stopped, continue it with --resume %s: %w",
+			run.RunID, run.RunID, errors.Join(failed...))
+	}
+	fmt.Printf("Replay this dataset with --seed %d\n", run.Seed)
+	return nil
 }
 
-func generateULIDData(db *gorm.DB, totalRecords, batchSize int) {
-	for i := 0; i < totalRecords; i += batchSize {
+func generateULIDData(db *gorm.DB, totalRecords, batchSize, ordersPerUser int, opts generateOptions) error {
+	profiles := newProfileSource(opts)
+	profiles.skip(opts.Start, batchSize, ordersPerUser)
+	for i := opts.Start; i < totalRecords; i += batchSize {
+		if reached, err := opts.reached(db); err != nil || reached {
+			return err
+		}
 		remaining := totalRecords - i
 		if remaining > batchSize {
 			remaining = batchSize
@@ -68,28 +277,42 @@ func generateULIDData(db *gorm.DB, totalRecords, batchSize int) {
 
 		var users []models.UserUlid
 		for j := 0; j < remaining; j++ {
-			firstName := gofakeit.FirstName()
-			lastName := gofakeit.LastName()
 			users = append(users, models.UserUlid{
-				ID: ulid.Make().String(),
-				UserBase: &models.UserBase{
-					UserName:   firstName,
-					FirstName:  lastName,
-					LastName:   gofakeit.LastName(),
-					Email:      fmt.Sprintf("%s%s@example.com", firstName, lastName),
-					Department: &gofakeit.Job().Title,
-				},
+				ID:       ulid.Make().String(),
+				UserBase: profiles.User(),
 			})
 		}
 
-		if err := db.CreateInBatches(users, batchSize).Error; err != nil {
-			log.Fatalf("Failed to insert ULID batch: %v", err)
+		var orders []models.OrderUlid
+		for _, user := range users {
+			for k := 0; k < ordersPerUser; k++ {
+				orders = append(orders, models.OrderUlid{ID: ulid.Make().String(), UserID: user.ID, OrderBase: profiles.Order()})
+			}
+		}
+
+		err := opts.writeBatch(db, i+len(users), len(users), func(tx *gorm.DB) error {
+			if err := opts.Insert(tx, users, batchSize); err != nil {
+				return err
+			}
+			if len(orders) == 0 {
+				return nil
+			}
+			return opts.Insert(tx.Omit("User"), orders, batchSize)
+		})
+		if err != nil {
+			return fmt.Errorf("insert ULID batch at user %d: %w", i, err)
 		}
 	}
+	return nil
 }
 
-func generateKSUIDData(db *gorm.DB, totalRecords, batchSize int) {
-	for i := 0; i < totalRecords; i += batchSize {
+func generateKSUIDData(db *gorm.DB, totalRecords, batchSize, ordersPerUser int, opts generateOptions) error {
+	profiles := newProfileSource(opts)
+	profiles.skip(opts.Start, batchSize, ordersPerUser)
+	for i := opts.Start; i < totalRecords; i += batchSize {
+		if reached, err := opts.reached(db); err != nil || reached {
+			return err
+		}
 		remaining := totalRecords - i
 		if remaining > batchSize {
 			remaining = batchSize
@@ -97,28 +320,42 @@ func generateKSUIDData(db *gorm.DB, totalRecords, batchSize int) {
 
 		var users []models.UserKSUID
 		for j := 0; j < remaining; j++ {
-			firstName := gofakeit.FirstName()
-			lastName := gofakeit.LastName()
 			users = append(users, models.UserKSUID{
-				ID: ksuid.New().String(),
-				UserBase: &models.UserBase{
-					UserName:   gofakeit.Username(),
-					FirstName:  firstName,
-					LastName:   lastName,
-					Email:      fmt.Sprintf("%c%s@gmail.com", firstName[0], lastName),
-					Department: &gofakeit.Job().Title,
-				},
+				ID:       ksuid.New().String(),
+				UserBase: profiles.User(),
 			})
 		}
 
-		if err := db.CreateInBatches(users, batchSize).Error; err != nil {
-			log.Fatalf("Failed to insert KSUID batch: %v", err)
+		var orders []models.OrderKSUID
+		for _, user := range users {
+			for k := 0; k < ordersPerUser; k++ {
+				orders = append(orders, models.OrderKSUID{ID: ksuid.New().String(), UserID: user.ID, OrderBase: profiles.Order()})
+			}
+		}
+
+		err := opts.writeBatch(db, i+len(users), len(users), func(tx *gorm.DB) error {
+			if err := opts.Insert(tx, users, batchSize); err != nil {
+				return err
+			}
+			if len(orders) == 0 {
+				return nil
+			}
+			return opts.Insert(tx.Omit("User"), orders, batchSize)
+		})
+		if err != nil {
+			return fmt.Errorf("insert KSUID batch at user %d: %w", i, err)
 		}
 	}
+	return nil
 }
 
-func generateUUID4Data(db *gorm.DB, totalRecords, batchSize int) {
-	for i := 0; i < totalRecords; i += batchSize {
+func generateUUID4Data(db *gorm.DB, totalRecords, batchSize, ordersPerUser int, opts generateOptions) error {
+	profiles := newProfileSource(opts)
+	profiles.skip(opts.Start, batchSize, ordersPerUser)
+	for i := opts.Start; i < totalRecords; i += batchSize {
+		if reached, err := opts.reached(db); err != nil || reached {
+			return err
+		}
 		remaining := totalRecords - i
 		if remaining > batchSize {
 			remaining = batchSize
@@ -126,33 +363,47 @@ func generateUUID4Data(db *gorm.DB, totalRecords, batchSize int) {
 
 		var users []models.UserUUID
 		for j := 0; j < remaining; j++ {
-			firstName := gofakeit.FirstName()
-			lastName := gofakeit.LastName()
 			users = append(users, models.UserUUID{
-				ID: uuid.New().String(),
-				UserBase: &models.UserBase{
-					UserName:   gofakeit.Username(),
-					FirstName:  firstName,
-					LastName:   lastName,
-					Email:      fmt.Sprintf("%c%s@%s.com", firstName[0], lastName, gofakeit.Company()),
-					Department: &gofakeit.Job().Title,
-				},
+				ID:       uuid.New().String(),
+				UserBase: profiles.User(),
 			})
 		}
 
-		if err := db.CreateInBatches(users, batchSize).Error; err != nil {
-			log.Fatalf("Failed to insert UUID4 batch: %v", err)
+		var orders []models.OrderUUID
+		for _, user := range users {
+			for k := 0; k < ordersPerUser; k++ {
+				orders = append(orders, models.OrderUUID{ID: uuid.New().String(), UserID: user.ID, OrderBase: profiles.Order()})
+			}
+		}
+
+		err := opts.writeBatch(db, i+len(users), len(users), func(tx *gorm.DB) error {
+			if err := opts.Insert(tx, users, batchSize); err != nil {
+				return err
+			}
+			if len(orders) == 0 {
+				return nil
+			}
+			return opts.Insert(tx.Omit("User"), orders, batchSize)
+		})
+		if err != nil {
+			return fmt.Errorf("insert UUID4 batch at user %d: %w", i, err)
 		}
 	}
+	return nil
 }
 
 // Performance measurement helpers
-func generateSnowflakeData(db *gorm.DB, totalRecords, batchSize int) {
+func generateSnowflakeData(db *gorm.DB, totalRecords, batchSize, ordersPerUser int, opts generateOptions) error {
+	profiles := newProfileSource(opts)
+	profiles.skip(opts.Start, batchSize, ordersPerUser)
 	node, err := snowflake.NewNode(1)
 	if err != nil {
-		log.Fatalf("Failed to create Snowflake node: %v", err)
+		return err
 	}
-	for i := 0; i < totalRecords; i += batchSize {
+	for i := opts.Start; i < totalRecords; i += batchSize {
+		if reached, err := opts.reached(db); err != nil || reached {
+			return err
+		}
 		remaining := totalRecords - i
 		if remaining > batchSize {
 			remaining = batchSize
@@ -160,28 +411,42 @@ func generateSnowflakeData(db *gorm.DB, totalRecords, batchSize int) {
 
 		var users []models.UserSnowflake
 		for j := 0; j < remaining; j++ {
-			firstName := gofakeit.FirstName()
-			lastName := gofakeit.LastName()
 			users = append(users, models.UserSnowflake{
-				ID: node.Generate().Int64(),
-				UserBase: &models.UserBase{
-					UserName:   gofakeit.Username(),
-					FirstName:  firstName,
-					LastName:   lastName,
-					Email:      fmt.Sprintf("%s%c@hotmail.com", firstName, lastName[0]),
-					Department: &gofakeit.Job().Title,
-				},
+				ID:       node.Generate().Int64(),
+				UserBase: profiles.User(),
 			})
 		}
 
-		if err := db.CreateInBatches(users, batchSize).Error; err != nil {
-			log.Fatalf("Failed to insert UUID4 batch: %v", err)
+		var orders []models.OrderSnowflake
+		for _, user := range users {
+			for k := 0; k < ordersPerUser; k++ {
+				orders = append(orders, models.OrderSnowflake{ID: node.Generate().Int64(), UserID: user.ID, OrderBase: profiles.Order()})
+			}
+		}
+
+		err := opts.writeBatch(db, i+len(users), len(users), func(tx *gorm.DB) error {
+			if err := opts.Insert(tx, users, batchSize); err != nil {
+				return err
+			}
+			if len(orders) == 0 {
+				return nil
+			}
+			return opts.Insert(tx.Omit("User"), orders, batchSize)
+		})
+		if err != nil {
+			return fmt.Errorf("insert Snowflake batch at user %d: %w", i, err)
 		}
 	}
+	return nil
 }
 
-func generateNanoIDData(db *gorm.DB, totalRecords, batchSize int) {
-	for i := 0; i < totalRecords; i += batchSize {
+func generateNanoIDData(db *gorm.DB, totalRecords, batchSize, ordersPerUser int, opts generateOptions) error {
+	profiles := newProfileSource(opts)
+	profiles.skip(opts.Start, batchSize, ordersPerUser)
+	for i := opts.Start; i < totalRecords; i += batchSize {
+		if reached, err := opts.reached(db); err != nil || reached {
+			return err
+		}
 		remaining := totalRecords - i
 		if remaining > batchSize {
 			remaining = batchSize
@@ -190,64 +455,51 @@ func generateNanoIDData(db *gorm.DB, totalRecords, batchSize int) {
 		var users []models.UserNanoID
 		for j := 0; j < remaining; j++ {
 			id, err := gonanoid.New()
-			firstName := gofakeit.FirstName()
-			lastName := gofakeit.LastName()
 
 			if err != nil {
-				log.Fatalf("Failed to generate NanoID: %v", err)
+				return fmt.Errorf("generate NanoID: %w", err)
 			}
 
 			users = append(users, models.UserNanoID{
-				ID: id,
-				UserBase: &models.UserBase{
-					UserName:   gofakeit.Username(),
-					FirstName:  firstName,
-					LastName:   lastName,
-					Email:      fmt.Sprintf("%s%s@%s.net", firstName[0:2], lastName, gofakeit.Company()),
-					Department: &gofakeit.Job().Title,
-				},
+				ID:       id,
+				UserBase: profiles.User(),
 			})
 		}
 
-		if err := db.CreateInBatches(users, batchSize).Error; err != nil {
-			log.Fatalf("Failed to insert UUID4 batch: %v", err)
-		}
-	}
-}
-
-func generateKuidData(db *gorm.DB, totalRecords, batchSize int) {
-	for i := 0; i < totalRecords; i += batchSize {
-		remaining := totalRecords - i
-		if remaining > batchSize {
-			remaining = batchSize
-		}
-
-		var users []models.UserKSUID
-		for j := 0; j < remaining; j++ {
-			id := ksuid.New()
-			firstName := gofakeit.FirstName()
-			lastName := gofakeit.LastName()
-
-			users = append(users, models.UserKSUID{
-				ID: id.String(),
-				UserBase: &models.UserBase{
-					UserName:   gofakeit.Username(),
-					FirstName:  firstName,
-					LastName:   lastName,
-					Email:      fmt.Sprintf("%s.%s@%s.co", firstName[0:3], lastName, gofakeit.Company()),
-					Department: &gofakeit.Job().Title,
-				},
-			})
+		var orders []models.OrderNanoID
+		for _, user := range users {
+			for k := 0; k < ordersPerUser; k++ {
+				id, err := gonanoid.New()
+				if err != nil {
+					return fmt.Errorf("generate NanoID: %w", err)
+				}
+				orders = append(orders, models.OrderNanoID{ID: id, UserID: user.ID, OrderBase: profiles.Order()})
+			}
 		}
 
-		if err := db.CreateInBatches(users, batchSize).Error; err != nil {
-			log.Fatalf("Failed to insert UUID4 batch: %v", err)
+		err := opts.writeBatch(db, i+len(users), len(users), func(tx *gorm.DB) error {
+			if err := opts.Insert(tx, users, batchSize); err != nil {
+				return err
+			}
+			if len(orders) == 0 {
+				return nil
+			}
+			return opts.Insert(tx.Omit("User"), orders, batchSize)
+		})
+		if err != nil {
+			return fmt.Errorf("insert NanoID batch at user %d: %w", i, err)
 		}
 	}
+	return nil
 }
 
-func generateCUIDData(db *gorm.DB, totalRecords, batchSize int) {
-	for i := 0; i < totalRecords; i += batchSize {
+func generateCUIDData(db *gorm.DB, totalRecords, batchSize, ordersPerUser int, opts generateOptions) error {
+	profiles := newProfileSource(opts)
+	profiles.skip(opts.Start, batchSize, ordersPerUser)
+	for i := opts.Start; i < totalRecords; i += batchSize {
+		if reached, err := opts.reached(db); err != nil || reached {
+			return err
+		}
 		remaining := totalRecords - i
 		if remaining > batchSize {
 			remaining = batchSize
@@ -255,22 +507,31 @@ func generateCUIDData(db *gorm.DB, totalRecords, batchSize int) {
 
 		var users []models.UserCUID
 		for j := 0; j < remaining; j++ {
-			firstName := gofakeit.FirstName()
-			lastName := gofakeit.LastName()
 			users = append(users, models.UserCUID{
-				ID: cuid2.Generate(),
-				UserBase: &models.UserBase{
-					UserName:   gofakeit.Username(),
-					FirstName:  firstName,
-					LastName:   lastName,
-					Email:      fmt.Sprintf("%s%s@%s.app", firstName, lastName, gofakeit.Company()),
-					Department: &gofakeit.Job().Title,
-				},
+				ID:       cuid2.Generate(),
+				UserBase: profiles.User(),
 			})
 		}
 
-		if err := db.CreateInBatches(users, batchSize).Error; err != nil {
-			log.Fatalf("Failed to insert ULID batch: %v", err)
+		var orders []models.OrderCUID
+		for _, user := range users {
+			for k := 0; k < ordersPerUser; k++ {
+				orders = append(orders, models.OrderCUID{ID: cuid2.Generate(), UserID: user.ID, OrderBase: profiles.Order()})
+			}
+		}
+
+		err := opts.writeBatch(db, i+len(users), len(users), func(tx *gorm.DB) error {
+			if err := opts.Insert(tx, users, batchSize); err != nil {
+				return err
+			}
+			if len(orders) == 0 {
+				return nil
+			}
+			return opts.Insert(tx.Omit("User"), orders, batchSize)
+		})
+		if err != nil {
+			return fmt.Errorf("insert CUID batch at user %d: %w", i, err)
 		}
 	}
+	return nil
 }
@@ -21,16 +21,16 @@ func TestApi(config *models.CmdConfig) {
 		name     string
 		endpoint string
 	}{
-		{"ULID", "/api/ulid"},
-		{"UUID", "/api/uuid4"},
-		{"KSUID", "/api/ksuid"},
-		{"CUID", "/api/cuid"},
-		{"NanoID", "/api/nano"},
-		{"Snowflake", "/api/snow"},
+		{"ULID", "/api/v1/ids/ulid/users/batch"},
+		{"UUID", "/api/v1/ids/uuid/users/batch"},
+		{"KSUID", "/api/v1/ids/ksuid/users/batch"},
+		{"CUID", "/api/v1/ids/cuid/users/batch"},
+		{"NanoID", "/api/v1/ids/nanoid/users/batch"},
+		{"Snowflake", "/api/v1/ids/snowflake/users/batch"},
 	}
 
-	fmt.Printf("Load testing %d requests per endpoint across %d endpoints...\n",
-		config.RecordsPerTable, len(endpoints))
+	fmt.Printf("Load testing %d users in batches of %d per endpoint across %d endpoints...\n",
+		config.RecordsPerTable, config.BatchSize, len(endpoints))
 	fmt.Printf("Concurrency: %d requests per endpoint\n", config.ConcurrentReqs)
 
 	start := time.Now()
@@ -47,8 +47,9 @@ func TestApi(config *models.CmdConfig) {
 
 			stats := loadTestEndpoint(client, config, name, endpoint)
 
-			fmt.Printf("✅ %s: %d requests in %v (avg: %.2fms, success: %.1f%%)\n",
+			fmt.Printf("✅ %s: %d users in %d requests in %v (avg: %.2fms, success: %.1f%%)\n",
 				name,
+				stats.UsersCreated,
 				stats.TotalRequests,
 				stats.Duration,
 				stats.AvgDuration,
@@ -60,12 +61,13 @@ func TestApi(config *models.CmdConfig) {
 	wg.Wait()
 	totalDuration := time.Since(start)
 
-	fmt.Printf("\n🎉 Total: %d requests across all endpoints in %v\n",
+	fmt.Printf("\n🎉 Total: %d users across all endpoints in %v\n",
 		config.RecordsPerTable*len(endpoints), totalDuration)
 }
 
 type EndpointStats struct {
 	TotalRequests int
+	UsersCreated  int64
 	SuccessCount  int64
 	ErrorCount    int64
 	Duration      time.Duration
@@ -77,6 +79,7 @@ func loadTestEndpoint(client *http.Client, config *models.CmdConfig, name, endpo
 	var (
 		successCount  int64
 		errorCount    int64
+		usersCreated  int64
 		totalDuration int64 // microseconds
 		wg            sync.WaitGroup
 		semaphore     = make(chan struct{}, config.ConcurrentReqs)
@@ -84,56 +87,57 @@ func loadTestEndpoint(client *http.Client, config *models.CmdConfig, name, endpo
 
 	start := time.Now()
 
-	for i := 0; i < config.RecordsPerTable; i++ {
+	batchSize := max(config.BatchSize, 1)
+	for i := 0; i < config.RecordsPerTable; i += batchSize {
 		wg.Add(1)
 		semaphore <- struct{}{} // Acquire
 
-		go func(index int) {
+		go func(index, count int) {
 			defer wg.Done()
 			defer func() { <-semaphore }() // Release
 
-			userName := gofakeit.Username()
-			firstName := gofakeit.FirstName()
-			lastName := gofakeit.LastName()
-			email := fmt.Sprintf("%c%s@%s.com", firstName[0], lastName, gofakeit.Company())
-			department := &gofakeit.Job().Title
-
 			// Generate fake user data
-			user := models.UserInput{
-				UserName:   &userName,
-				FirstName:  &firstName,
-				LastName:   &lastName,
-				Email:      &email,
-				Department: department,
+			users := make([]models.UserInput, 0, count)
+			for j := 0; j < count; j++ {
+				userName := gofakeit.Username()
+				firstName := gofakeit.FirstName()
+				lastName := gofakeit.LastName()
+				email := fmt.Sprintf("%c%s@%s.com", firstName[0], lastName, gofakeit.Company())
+				department := &gofakeit.Job().Title
+
+				users = append(users, models.UserInput{
+					UserName:   &userName,
+					FirstName:  &firstName,
+					LastName:   &lastName,
+					Email:      &email,
+					Department: department,
+				})
 			}
 
 			// Make HTTP request
 			reqStart := time.Now()
-			err := createUser(client, config.BaseURL+endpoint, user)
+			created, err := createUsers(client, config.BaseURL+endpoint, users)
 			reqDuration := time.Since(reqStart)
 
 			// Track stats
 			atomic.AddInt64(&totalDuration, reqDuration.Microseconds())
+			atomic.AddInt64(&usersCreated, int64(created))
 
 			if err != nil {
 				atomic.AddInt64(&errorCount, 1)
-				if index%100 == 0 { // Don't spam errors
-					fmt.Printf("  ⚠️  %s error: %v\n", name, err)
-				}
+				fmt.Printf("  ⚠️  %s error: %v\n", name, err)
 			} else {
 				atomic.AddInt64(&successCount, 1)
 			}
 
 			// Progress update
-			if (index+1)%config.BatchSize == 0 {
-				fmt.Printf("  %s progress: %d/%d requests\n", name, index+1, config.RecordsPerTable)
-			}
+			fmt.Printf("  %s progress: %d/%d users\n", name, index+count, config.RecordsPerTable)
 
 			// Rate limiting
 			if config.DelayBetweenReqs > 0 {
 				time.Sleep(config.DelayBetweenReqs)
 			}
-		}(i)
+		}(i, min(batchSize, config.RecordsPerTable-i))
 	}
 
 	wg.Wait()
@@ -146,6 +150,7 @@ func loadTestEndpoint(client *http.Client, config *models.CmdConfig, name, endpo
 
 	return EndpointStats{
 		TotalRequests: totalReqs,
+		UsersCreated:  usersCreated,
 		SuccessCount:  successCount,
 		ErrorCount:    errorCount,
 		Duration:      duration,
@@ -154,17 +159,18 @@ func loadTestEndpoint(client *http.Client, config *models.CmdConfig, name, endpo
 	}
 }
 
-func createUser(client *http.Client, url string, user models.UserInput) error {
-	// Marshal user to JSON
-	payload, err := json.Marshal(user)
+// createUsers posts one bulk create and returns how many users it created.
+func createUsers(client *http.Client, url string, users []models.UserInput) (int, error) {
+	// Marshal users to JSON
+	payload, err := json.Marshal(models.BatchCreateRequest{Users: users})
 	if err != nil {
-		return fmt.Errorf("marshal error: %w", err)
+		return 0, fmt.Errorf("marshal error: %w", err)
 	}
 
 	// Create request
 	req, err := http.NewRequest("POST", url, bytes.NewBuffer(payload))
 	if err != nil {
-		return fmt.Errorf("request creation error: %w", err)
+		return 0, fmt.Errorf("request creation error: %w", err)
 	}
 
 	req.Header.Set("Content-Type", "application/json")
@@ -172,18 +178,22 @@ func createUser(client *http.Client, url string, user models.UserInput) error {
 	// Send request
 	resp, err := client.Do(req)
 	if err != nil {
-		return fmt.Errorf("request error: %w", err)
+		return 0, fmt.Errorf("request error: %w", err)
 	}
 	defer resp.Body.Close()
 
 	// Check status code
 	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
 		body, _ := io.ReadAll(resp.Body)
-		return fmt.Errorf("HTTP %d: %s", resp.StatusCode, string(body))
+		return 0, fmt.Errorf("HTTP %d: %s", resp.StatusCode, string(body))
 	}
 
-	// Drain body to reuse connection
-	io.Copy(io.Discard, resp.Body)
-
-	return nil
+	var result models.BatchResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return 0, fmt.Errorf("decode error: %w", err)
+	}
+	if result.Failed > 0 {
+		return result.Succeeded, fmt.Errorf("%d of %d users failed", result.Failed, len(users))
+	}
+	return result.Succeeded, nil
 }
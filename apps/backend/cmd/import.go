@@ -0,0 +1,363 @@
+package cmd
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/bwmarrin/snowflake"
+	"github.com/google/uuid"
+	gonanoid "github.com/matoous/go-nanoid/v2"
+	"github.com/nrednav/cuid2"
+	"github.com/oklog/ulid/v2"
+	"github.com/segmentio/ksuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/theCompanyDream/id-trials/apps/backend/models"
+	"github.com/theCompanyDream/id-trials/apps/backend/utils"
+)
+
+// importChunkSize is the number of rows per multi-row INSERT, so a --batch of
+// any size stays below the 65535 bind parameters Postgres accepts in one
+// statement.
+const importChunkSize = 1000
+
+// importRecord is one user read from the import file, in file order.
+type importRecord struct {
+	Line int
+	User models.UserDTO
+}
+
+// ImportReport counts what an import did per ID type.
+type ImportReport struct {
+	Read     int            // Records read from the file
+	Invalid  int            // Records skipped for missing required fields
+	Inserted map[string]int // Rows inserted per ID type
+	Skipped  map[string]int // Rows skipped per ID type: invalid preserved IDs or IDs already present
+}
+
+// ImportTarget loads records into the users table of one ID type.
+type ImportTarget struct {
+	Name   string
+	Tag    string // validator tag of the ID format
+	insert func(db *gorm.DB, records []importRecord, preserve bool) (inserted int, mapping [][]string, err error)
+}
+
+// importInto builds the import of one ID type. newID generates an ID and build
+// turns an ID and its columns into a row.
+func importInto[T any](name, tag string, newID func() (string, error), build func(id string, base *models.UserBase) (T, error)) ImportTarget {
+	insert := func(db *gorm.DB, records []importRecord, preserve bool) (int, [][]string, error) {
+		rows := make([]T, 0, len(records))
+		var mapping [][]string
+		for _, record := range records {
+			id := record.User.ID
+			if preserve {
+				if !utils.ValidID(tag, id) {
+					continue
+				}
+			} else {
+				generated, err := newID()
+				if err != nil {
+					return 0, nil, err
+				}
+				mapping = append(mapping, []string{strconv.Itoa(record.Line), id, generated})
+				id = generated
+			}
+
+			row, err := build(id, userBase(record.User))
+			if err != nil {
+				return 0, nil, err
+			}
+			rows = append(rows, row)
+		}
+		if len(rows) == 0 {
+			return 0, mapping, nil
+		}
+
+		// Preserved IDs may already be loaded; skip those rather than abort
+		result := db.Clauses(clause.OnConflict{DoNothing: true}).CreateInBatches(&rows, importChunkSize)
+		if result.Error != nil {
+			return 0, nil, result.Error
+		}
+		return int(result.RowsAffected), mapping, nil
+	}
+	return ImportTarget{Name: name, Tag: tag, insert: insert}
+}
+
+func userBase(user models.UserDTO) *models.UserBase {
+	return &models.UserBase{
+		UserName:   user.UserName,
+		FirstName:  user.FirstName,
+		LastName:   user.LastName,
+		Email:      user.Email,
+		Department: user.Department,
+	}
+}
+
+// ImportTargets returns the import of every ID type.
+func ImportTargets(node *snowflake.Node) []ImportTarget {
+	str := func(generate func() string) func() (string, error) {
+		return func() (string, error) { return generate(), nil }
+	}
+	return []ImportTarget{
+		importInto("ULID", "ulid", str(func() string { return ulid.Make().String() }),
+			func(id string, base *models.UserBase) (models.UserUlid, error) {
+				return models.UserUlid{ID: id, UserBase: base}, nil
+			}),
+		importInto("KSUID", "ksuid", str(func() string { return ksuid.New().String() }),
+			func(id string, base *models.UserBase) (models.UserKSUID, error) {
+				return models.UserKSUID{ID: id, UserBase: base}, nil
+			}),
+		importInto("UUID", "uuid4", str(func() string { return uuid.New().String() }),
+			func(id string, base *models.UserBase) (models.UserUUID, error) {
+				return models.UserUUID{ID: id, UserBase: base}, nil
+			}),
+		importInto("CUID", "cuid2", str(cuid2.Generate),
+			func(id string, base *models.UserBase) (models.UserCUID, error) {
+				return models.UserCUID{ID: id, UserBase: base}, nil
+			}),
+		importInto("NanoID", "nanoid", func() (string, error) { return gonanoid.New() },
+			func(id string, base *models.UserBase) (models.UserNanoID, error) {
+				return models.UserNanoID{ID: id, UserBase: base}, nil
+			}),
+		importInto("Snowflake", "snowflake", str(func() string { return node.Generate().String() }),
+			func(id string, base *models.UserBase) (models.UserSnowflake, error) {
+				value, err := strconv.ParseInt(id, 10, 64)
+				return models.UserSnowflake{ID: value, UserBase: base}, err
+			}),
+	}
+}
+
+// SelectTargets keeps the targets named in types, matched case-insensitively
+// by name or validator tag. No types selects every target.
+func SelectTargets(targets []ImportTarget, types []string) ([]ImportTarget, error) {
+	return selectIDTypes(targets, types, func(target ImportTarget) (string, string) {
+		return target.Name, target.Tag
+	})
+}
+
+// selectIDTypes keeps the items named in types, matched case-insensitively by
+// the name or validator tag that names returns for them. No types selects
+// every item.
+func selectIDTypes[T any](items []T, types []string, names func(T) (name, tag string)) ([]T, error) {
+	if len(types) == 0 {
+		return items, nil
+	}
+	selected := make([]T, 0, len(types))
+	for _, typeName := range types {
+		typeName = strings.TrimSpace(typeName)
+		found := false
+		for _, item := range items {
+			name, tag := names(item)
+			if strings.EqualFold(typeName, name) || strings.EqualFold(typeName, tag) {
+				selected = append(selected, item)
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("unknown ID type %q", typeName)
+		}
+	}
+	return selected, nil
+}
+
+// importReader yields records from an NDJSON or CSV stream one at a time, so
+// files of any size are read in constant memory.
+type importReader func() (*importRecord, error)
+
+func newImportReader(input io.Reader, format string) (importReader, error) {
+	line := 0
+	switch format {
+	case "ndjson", "jsonl", "json":
+		decoder := json.NewDecoder(bufio.NewReader(input))
+		return func() (*importRecord, error) {
+			var user models.UserDTO
+			if err := decoder.Decode(&user); err != nil {
+				return nil, err
+			}
+			line++
+			return &importRecord{Line: line, User: user}, nil
+		}, nil
+	case "csv":
+		reader := csv.NewReader(bufio.NewReader(input))
+		reader.FieldsPerRecord = -1
+		header, err := reader.Read()
+		if err != nil {
+			return nil, fmt.Errorf("read CSV header: %w", err)
+		}
+		columns := make(map[string]int, len(header))
+		for i, name := range header {
+			columns[strings.TrimSpace(strings.ToLower(name))] = i
+		}
+		field := func(row []string, name string) string {
+			if i, ok := columns[name]; ok && i < len(row) {
+				return strings.TrimSpace(row[i])
+			}
+			return ""
+		}
+		return func() (*importRecord, error) {
+			row, err := reader.Read()
+			if err != nil {
+				return nil, err
+			}
+			line++
+			user := models.UserDTO{
+				ID:        field(row, "id"),
+				UserName:  field(row, "user_name"),
+				FirstName: field(row, "first_name"),
+				LastName:  field(row, "last_name"),
+				Email:     field(row, "email"),
+			}
+			if department := field(row, "department"); department != "" {
+				user.Department = &department
+			}
+			return &importRecord{Line: line, User: user}, nil
+		}, nil
+	}
+	return nil, fmt.Errorf("unknown import format %q, expected ndjson or csv", format)
+}
+
+// ImportFormat returns the explicit format, or the one implied by the file extension.
+func ImportFormat(file, format string) string {
+	if format != "" {
+		return strings.ToLower(format)
+	}
+	if strings.EqualFold(filepath.Ext(file), ".csv") {
+		return "csv"
+	}
+	return "ndjson"
+}
+
+// ImportUsers loads the records of input into every target, one batch at a
+// time. With preserve set, records keep their IDs when valid for the type;
+// otherwise each type generates its own and the pairs go to mapping as CSV.
+func ImportUsers(db *gorm.DB, input io.Reader, format string, targets []ImportTarget, preserve bool, batchSize int, mapping io.Writer) (*ImportReport, error) {
+	next, err := newImportReader(input, format)
+	if err != nil {
+		return nil, err
+	}
+	if batchSize <= 0 {
+		batchSize = 1000
+	}
+
+	var mappingCSV *csv.Writer
+	if mapping != nil && !preserve {
+		mappingCSV = csv.NewWriter(mapping)
+		mappingCSV.Write([]string{"id_type", "line", "source_id", "new_id"})
+	}
+
+	report := &ImportReport{Inserted: map[string]int{}, Skipped: map[string]int{}}
+	flush := func(batch []importRecord) error {
+		for _, target := range targets {
+			inserted, pairs, err := target.insert(db, batch, preserve)
+			if err != nil {
+				return fmt.Errorf("import %s: %w", target.Name, err)
+			}
+			report.Inserted[target.Name] += inserted
+			report.Skipped[target.Name] += len(batch) - inserted
+			if mappingCSV != nil {
+				for _, pair := range pairs {
+					mappingCSV.Write(append([]string{target.Name}, pair...))
+				}
+			}
+		}
+		if mappingCSV != nil {
+			mappingCSV.Flush()
+			return mappingCSV.Error()
+		}
+		return nil
+	}
+
+	batch := make([]importRecord, 0, batchSize)
+	for {
+		record, err := next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return report, fmt.Errorf("read record %d: %w", report.Read+1, err)
+		}
+		report.Read++
+
+		user := record.User
+		if user.UserName == "" || user.FirstName == "" || user.LastName == "" || user.Email == "" {
+			report.Invalid++
+			continue
+		}
+		batch = append(batch, *record)
+		if len(batch) == batchSize {
+			if err := flush(batch); err != nil {
+				return report, err
+			}
+			batch = batch[:0]
+		}
+	}
+	if len(batch) > 0 {
+		if err := flush(batch); err != nil {
+			return report, err
+		}
+	}
+	return report, nil
+}
+
+// ImportData runs ImportUsers for the import command.
+func ImportData(config *models.CmdConfig, db *gorm.DB) error {
+	node, err := snowflake.NewNode(1)
+	if err != nil {
+		return fmt.Errorf("create Snowflake node: %w", err)
+	}
+	targets, err := SelectTargets(ImportTargets(node), config.IDTypes)
+	if err != nil {
+		return err
+	}
+
+	input, err := os.Open(config.ImportFile)
+	if err != nil {
+		return fmt.Errorf("open %s: %w", config.ImportFile, err)
+	}
+	defer input.Close()
+
+	var mapping io.Writer
+	if !config.PreserveIDs && config.MappingFile != "" {
+		file, err := os.Create(config.MappingFile)
+		if err != nil {
+			return fmt.Errorf("create %s: %w", config.MappingFile, err)
+		}
+		defer file.Close()
+		mapping = file
+	}
+
+	mode := "regenerating IDs"
+	if config.PreserveIDs {
+		mode = "preserving IDs"
+	}
+	fmt.Printf("Importing %s into %d tables, %s...\n", config.ImportFile, len(targets), mode)
+
+	start := time.Now()
+	report, err := ImportUsers(db, input, ImportFormat(config.ImportFile, config.ImportFormat), targets, config.PreserveIDs, config.BatchSize, mapping)
+	if err != nil {
+		if report == nil {
+			return err
+		}
+		return fmt.Errorf("import failed after %d records: %w", report.Read, err)
+	}
+
+	for _, target := range targets {
+		fmt.Printf("✅ %s: inserted %d, skipped %d\n", target.Name, report.Inserted[target.Name], report.Skipped[target.Name])
+	}
+	fmt.Printf("\n Total: read %d records (%d missing required fields) in %v\n", report.Read, report.Invalid, time.Since(start))
+	if mapping != nil {
+		fmt.Printf("ID mapping written to %s\n", config.MappingFile)
+	}
+	return nil
+}
@@ -0,0 +1,79 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"text/tabwriter"
+
+	"gorm.io/gorm"
+
+	"github.com/theCompanyDream/id-trials/apps/backend/repository"
+)
+
+// MigrateUp applies up to steps pending migrations, or all when steps is 0.
+func MigrateUp(db *gorm.DB, steps int) error {
+	migrator, err := repository.NewMigrator(db, repository.Migrations())
+	if err != nil {
+		return err
+	}
+	applied, err := migrator.Up(steps)
+	for _, migration := range applied {
+		fmt.Printf("✅ Applied %04d_%s\n", migration.Version, migration.Name)
+	}
+	if err == nil && len(applied) == 0 {
+		fmt.Println("Schema is up to date")
+	}
+	return err
+}
+
+// MigrateDown rolls back up to steps applied migrations, or all when steps is 0.
+func MigrateDown(db *gorm.DB, steps int) error {
+	migrator, err := repository.NewMigrator(db, repository.Migrations())
+	if err != nil {
+		return err
+	}
+	rolledBack, err := migrator.Down(steps)
+	for _, migration := range rolledBack {
+		fmt.Printf("↩️  Rolled back %04d_%s\n", migration.Version, migration.Name)
+	}
+	if err == nil && len(rolledBack) == 0 {
+		fmt.Println("No migrations to roll back")
+	}
+	return err
+}
+
+// MigrationStatus writes every migration and when it was applied to out.
+func MigrationStatus(db *gorm.DB, out io.Writer) error {
+	migrator, err := repository.NewMigrator(db, repository.Migrations())
+	if err != nil {
+		return err
+	}
+	states, err := migrator.Status()
+	if err != nil {
+		return err
+	}
+
+	table := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(table, "VERSION\tNAME\tSTATUS\tAPPLIED AT")
+	for _, state := range states {
+		status, appliedAt := "pending", ""
+		if state.AppliedAt != nil {
+			status, appliedAt = "applied", state.AppliedAt.Format("2006-01-02 15:04:05")
+		}
+		switch {
+		case state.Unknown:
+			status = "unknown to this build"
+		case state.Modified:
+			status = "modified since applied"
+		}
+		fmt.Fprintf(table, "%04d\t%s\t%s\t%s\n", state.Version, state.Name, status, appliedAt)
+	}
+	if err := table.Flush(); err != nil {
+		return err
+	}
+
+	if err := migrator.Check(); err != nil {
+		fmt.Fprintf(out, "\n%v\n", err)
+	}
+	return nil
+}
@@ -0,0 +1,39 @@
+package cmd
+
+import (
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/theCompanyDream/id-trials/apps/backend/models"
+	"github.com/theCompanyDream/id-trials/apps/backend/repository"
+)
+
+// MaintainPartitions adds one partition per month around the current month to
+// the partitioned users tables the migrations create, and optionally backfills
+// them. It is safe to run repeatedly, e.g. from a monthly cron job.
+func MaintainPartitions(config *models.CmdConfig, db *gorm.DB) error {
+	partitionRepo := repository.NewPartitionRepository(db)
+	now := time.Now().UTC()
+
+	for _, spec := range repository.PartitionSpecs {
+		for offset := -config.MonthsBack; offset <= config.MonthsAhead; offset++ {
+			month := time.Date(now.Year(), now.Month()+time.Month(offset), 1, 0, 0, 0, 0, time.UTC)
+			if _, err := partitionRepo.CreateMonthPartition(spec, month); err != nil {
+				return fmt.Errorf("create %s partition for %s: %w", spec.Table, month.Format("2006-01"), err)
+			}
+		}
+		fmt.Printf("✅ %s: partitions from %d months back to %d months ahead\n",
+			spec.Table, config.MonthsBack, config.MonthsAhead)
+
+		if config.Backfill {
+			copied, err := partitionRepo.Backfill(spec)
+			if err != nil {
+				return fmt.Errorf("backfill %s: %w", spec.Table, err)
+			}
+			fmt.Printf("✅ %s: backfilled %d rows from %s\n", spec.Table, copied, spec.Source)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,100 @@
+package cmd
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"strings"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"gorm.io/gorm"
+
+	"github.com/theCompanyDream/id-trials/apps/backend/models"
+)
+
+// generateOptions configure the generation of one ID type.
+type generateOptions struct {
+	Insert  inserter
+	Seed    int64 // Seeds the profile source of every ID type
+	Start   int   // Users already written by the run being resumed
+	Retries int   // Retries of a batch that failed with a transient error
+
+	// Checkpoint records the users completed so far, in the transaction of
+	// the batch that completed them; nil skips checkpointing
+	Checkpoint func(tx *gorm.DB, completed int) error
+	// Progress is told how many users each written batch added; may be nil
+	Progress func(users int)
+	// Until reports whether the table is big enough to stop before the record
+	// count is reached; nil writes every record
+	Until func(db *gorm.DB) (bool, error)
+}
+
+// reached reports whether Until asks to stop before the next batch.
+func (o generateOptions) reached(db *gorm.DB) (bool, error) {
+	if o.Until == nil {
+		return false, nil
+	}
+	return o.Until(db)
+}
+
+// NewSeed picks a seed for runs that did not ask for one, so every run can
+// still be replayed from its recorded seed.
+func NewSeed() int64 {
+	return rand.Int64N(1<<53) + 1
+}
+
+// ordersUntil ends the year over which order dates are spread. It is fixed
+// rather than taken from the clock, so a seed replays the same dates whenever
+// the run is repeated.
+var ordersUntil = time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+// profileSource generates the fake users and orders of one ID type. Each ID
+// type gets its own source with the same seed, so the n-th user is the same
+// person in every table and table sizes and search selectivity match.
+type profileSource struct {
+	faker *gofakeit.Faker
+}
+
+func newProfileSource(opts generateOptions) *profileSource {
+	return &profileSource{faker: gofakeit.New(opts.Seed)}
+}
+
+// User builds the columns shared by every users table.
+func (p *profileSource) User() *models.UserBase {
+	firstName := p.faker.FirstName()
+	lastName := p.faker.LastName()
+	department := p.faker.JobTitle()
+	return &models.UserBase{
+		UserName:   p.faker.Username(),
+		FirstName:  firstName,
+		LastName:   lastName,
+		Email:      strings.ToLower(fmt.Sprintf("%c%s@example.com", firstName[0], lastName)),
+		Department: &department,
+	}
+}
+
+// skip replays the profiles of the first users written in batches of
+// batchSize, so a resumed run continues the sequence where it stopped.
+func (p *profileSource) skip(users, batchSize, ordersPerUser int) {
+	for i := 0; i < users; i += batchSize {
+		size := min(batchSize, users-i)
+		for j := 0; j < size; j++ {
+			p.User()
+		}
+		for j := 0; j < size*ordersPerUser; j++ {
+			p.Order()
+		}
+	}
+}
+
+// Order builds the columns shared by every orders table.
+func (p *profileSource) Order() *models.OrderBase {
+	quantity := p.faker.Number(1, 5)
+	return &models.OrderBase{
+		Product:    p.faker.ProductName(),
+		Quantity:   quantity,
+		TotalCents: int64(quantity) * int64(p.faker.Number(100, 50000)),
+		Status:     p.faker.RandomString([]string{"pending", "paid", "shipped", "cancelled"}),
+		CreatedAt:  p.faker.DateRange(ordersUntil.AddDate(-1, 0, 0), ordersUntil),
+	}
+}
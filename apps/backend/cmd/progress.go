@@ -0,0 +1,161 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// progressInterval is how often the progress display is redrawn.
+const progressInterval = 2 * time.Second
+
+// tableProgress counts the users written to one table in this session.
+type tableProgress struct {
+	name    string
+	total   int64
+	resumed int64 // Users already written before a resume
+	written atomic.Int64
+	failed  atomic.Bool
+
+	// Size mode: the table grows to targetSize bytes instead of total users
+	targetSize int64
+	size       atomic.Int64
+	startSize  atomic.Int64
+	sized      atomic.Bool
+	done       atomic.Bool
+
+	// Set by the table's generator before done, read once it has returned
+	err      error
+	duration time.Duration
+}
+
+// setSize records the latest measured size of the table.
+func (t *tableProgress) setSize(size int64) {
+	if t.sized.CompareAndSwap(false, true) {
+		t.startSize.Store(size)
+	}
+	t.size.Store(size)
+}
+
+// finish marks the table done after running for duration, failed if err is set.
+func (t *tableProgress) finish(duration time.Duration, err error) {
+	t.err, t.duration = err, duration
+	t.failed.Store(err != nil)
+	t.done.Store(true)
+}
+
+// progressDisplay shows the rows written, rows/sec and ETA of every table
+// while a generation runs. On a terminal it redraws in place; otherwise it
+// prints a block of lines each interval, which suits log files.
+type progressDisplay struct {
+	out      io.Writer
+	terminal bool
+	start    time.Time
+	tables   []*tableProgress
+	drawn    int // Lines drawn last time, to move the cursor back over
+	stop     chan struct{}
+	finished sync.WaitGroup
+}
+
+func newProgressDisplay(out io.Writer) *progressDisplay {
+	terminal := false
+	if file, ok := out.(*os.File); ok {
+		if info, err := file.Stat(); err == nil {
+			terminal = info.Mode()&os.ModeCharDevice != 0
+		}
+	}
+	return &progressDisplay{out: out, terminal: terminal, stop: make(chan struct{})}
+}
+
+// Table adds a table of total users, resumed of which are already written.
+func (p *progressDisplay) Table(name string, total, resumed int) *tableProgress {
+	table := &tableProgress{name: name, total: int64(total), resumed: int64(resumed)}
+	p.tables = append(p.tables, table)
+	return table
+}
+
+// Start redraws the display every interval until Stop.
+func (p *progressDisplay) Start(interval time.Duration) {
+	p.start = time.Now()
+	p.finished.Add(1)
+	go func() {
+		defer p.finished.Done()
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				p.draw()
+			case <-p.stop:
+				p.draw()
+				return
+			}
+		}
+	}()
+}
+
+// Stop draws the final state and ends the display.
+func (p *progressDisplay) Stop() {
+	close(p.stop)
+	p.finished.Wait()
+}
+
+func (p *progressDisplay) draw() {
+	elapsed := time.Since(p.start)
+	var b strings.Builder
+	if p.terminal && p.drawn > 0 {
+		fmt.Fprintf(&b, "\033[%dA", p.drawn)
+	}
+	for _, table := range p.tables {
+		written := table.written.Load()
+		completed := table.resumed + written
+		rate := rowsPerSecond(int(written), elapsed)
+
+		if table.targetSize > 0 {
+			size, grown := table.size.Load(), table.size.Load()-table.startSize.Load()
+			status := "ETA " + eta(table.targetSize-size, float64(grown)/elapsed.Seconds())
+			fmt.Fprintf(&b, "%-10s %6.2f%% %10s/%-10s %12d rows %10.0f rows/sec  %s", table.name,
+				min(float64(size)/float64(table.targetSize)*100, 100), formatSize(size), formatSize(table.targetSize),
+				completed, rate, table.status(status))
+		} else {
+			percent := 100.0
+			if table.total > 0 {
+				percent = float64(completed) / float64(table.total) * 100
+			}
+			fmt.Fprintf(&b, "%-10s %6.2f%% %12d/%-12d %10.0f rows/sec  %s", table.name, percent, completed, table.total,
+				rate, table.status("ETA "+eta(table.total-completed, rate)))
+		}
+		if p.terminal {
+			b.WriteString("\033[K")
+		}
+		b.WriteByte('\n')
+	}
+	p.drawn = len(p.tables)
+	fmt.Fprint(p.out, b.String())
+}
+
+// status is running while the table is being written, then done or failed.
+func (t *tableProgress) status(running string) string {
+	switch {
+	case t.failed.Load():
+		return "failed"
+	case t.done.Load():
+		return "done"
+	}
+	return running
+}
+
+// eta is the time left to write remaining rows, or bytes, at rate per second.
+func eta(remaining int64, rate float64) string {
+	if remaining <= 0 {
+		return "0s"
+	}
+	if rate <= 0 {
+		return "unknown"
+	}
+	return time.Duration(float64(remaining) / rate * float64(time.Second)).Round(time.Second).String()
+}
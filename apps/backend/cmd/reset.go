@@ -0,0 +1,68 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+
+	"gorm.io/gorm"
+
+	"github.com/theCompanyDream/id-trials/apps/backend/models"
+	"github.com/theCompanyDream/id-trials/apps/backend/repository"
+)
+
+// ResetData empties the users, partitioned users and orders tables of
+// config.IDTypes, and the metrics tables unless config.KeepMetrics is set.
+// Metrics are shared by every ID type, so they are reset as a whole. With
+// config.Drop every migration is rolled back and applied again instead, which
+// also drops any indexes added by hand and the monthly partitions; run
+// partition again to recreate them.
+func ResetData(config *models.CmdConfig, db *gorm.DB) error {
+	if config.Drop {
+		if len(config.IDTypes) > 0 || config.KeepMetrics {
+			return errors.New("--drop recreates the whole schema and cannot be combined with --types or --keep-metrics")
+		}
+		return recreateSchema(db)
+	}
+
+	generators, err := selectGenerators(config.IDTypes)
+	if err != nil {
+		return err
+	}
+	tables := idTables(generators)
+	if !config.KeepMetrics {
+		tables = append(tables, repository.MetricModels()...)
+	}
+	names, err := repository.TableNames(db, tables...)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Truncating %d tables...\n", len(tables))
+	if err := repository.TruncateTables(db, tables...); err != nil {
+		return fmt.Errorf("truncate tables: %w", err)
+	}
+
+	for _, name := range names {
+		fmt.Printf("✅ %s\n", name)
+	}
+	return nil
+}
+
+// recreateSchema rolls back every migration and applies them again.
+func recreateSchema(db *gorm.DB) error {
+	migrator, err := repository.NewMigrator(db, repository.Migrations())
+	if err != nil {
+		return err
+	}
+	down, err := migrator.Down(0)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("Rolled back %d migrations\n", len(down))
+	up, err := migrator.Up(0)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("✅ Applied %d migrations\n", len(up))
+	return nil
+}
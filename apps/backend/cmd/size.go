@@ -0,0 +1,50 @@
+package cmd
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// sizeUnits are the suffixes ParseSize accepts. Like Postgres' pg_size_pretty,
+// a kilobyte is 1024 bytes.
+var sizeUnits = []struct {
+	suffix string
+	bytes  int64
+}{
+	{"tib", 1 << 40}, {"tb", 1 << 40},
+	{"gib", 1 << 30}, {"gb", 1 << 30},
+	{"mib", 1 << 20}, {"mb", 1 << 20},
+	{"kib", 1 << 10}, {"kb", 1 << 10},
+	{"b", 1},
+}
+
+// ParseSize reads a size such as "10GB", "512 MB" or "1.5TB" as bytes.
+func ParseSize(size string) (int64, error) {
+	value := strings.ToLower(strings.TrimSpace(size))
+	multiplier := int64(1)
+	for _, unit := range sizeUnits {
+		if strings.HasSuffix(value, unit.suffix) {
+			value, multiplier = strings.TrimSpace(strings.TrimSuffix(value, unit.suffix)), unit.bytes
+			break
+		}
+	}
+	number, err := strconv.ParseFloat(value, 64)
+	if err != nil || number <= 0 {
+		return 0, fmt.Errorf("invalid size %q, expected e.g. 10GB or 512MB", size)
+	}
+	return int64(number * float64(multiplier)), nil
+}
+
+// formatSize writes bytes with the largest unit that keeps the value above 1.
+func formatSize(bytes int64) string {
+	for _, unit := range []struct {
+		name  string
+		bytes int64
+	}{{"TB", 1 << 40}, {"GB", 1 << 30}, {"MB", 1 << 20}, {"kB", 1 << 10}} {
+		if bytes >= unit.bytes {
+			return fmt.Sprintf("%.1f %s", float64(bytes)/float64(unit.bytes), unit.name)
+		}
+	}
+	return fmt.Sprintf("%d B", bytes)
+}
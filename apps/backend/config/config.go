@@ -0,0 +1,276 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"os"
+	"regexp"
+	"strconv"
+	"time"
+
+	"github.com/labstack/gommon/bytes"
+	"gopkg.in/yaml.v3"
+)
+
+// Search modes of GetUsers, see repository.ApplySearch.
+const (
+	SearchModeILike    = "ilike"
+	SearchModeTrigram  = "trigram"
+	SearchModeFullText = "fulltext"
+)
+
+// EnvConfigFile names the config file when no --config flag is given.
+const EnvConfigFile = "CONFIG_FILE"
+
+// Config is the configuration of the server and the CLI commands. Every field
+// can be set in the config file (yaml tag), by an environment variable (env
+// tag) and, for some, by a command line flag (flag tag).
+type Config struct {
+	Server   Server   `yaml:"server"`
+	Database Database `yaml:"database"`
+	Limits   Limits   `yaml:"limits"`
+	Metrics  Metrics  `yaml:"metrics"`
+	Search   Search   `yaml:"search"`
+	Storage  Storage  `yaml:"storage"`
+	Debug    Debug    `yaml:"debug"`
+}
+
+// Server is where the HTTP and gRPC servers listen and whom they serve.
+type Server struct {
+	Host           string   `yaml:"host" env:"BACKEND_HOST" flag:"host"`
+	Port           int      `yaml:"port" env:"BACKEND_PORT" flag:"port"`
+	GRPCPort       int      `yaml:"grpc_port" env:"GRPC_PORT" flag:"grpc-port"` // 0 disables the gRPC server
+	AllowedOrigins []string `yaml:"allowed_origins" env:"ALLOWED_HOSTS"`        // CORS origins; none disables CORS
+
+	// Graceful shutdown
+	DrainDelay   time.Duration `yaml:"drain_delay" env:"DRAIN_DELAY"`     // How long /readyz fails before the listener closes
+	DrainTimeout time.Duration `yaml:"drain_timeout" env:"DRAIN_TIMEOUT"` // How long in-flight requests and metric writes may take
+}
+
+// Database is the Postgres connection, either a URL or its parts.
+type Database struct {
+	URL      string `yaml:"url" env:"POSTGRES_URL" flag:"database"` // Overrides the parts below when set
+	Host     string `yaml:"host" env:"DATABASE_HOST"`
+	Port     int    `yaml:"port" env:"DATABASE_PORT"`
+	User     string `yaml:"user" env:"DATABASE_USERNAME"`
+	Password string `yaml:"password" env:"DATABASE_PASSWORD"`
+	Name     string `yaml:"name" env:"DATABASE_NAME"`
+	SSLMode  string `yaml:"sslmode" env:"DATABASE_SSLMODE"`
+
+	// Connection pool; benchmark results depend on these, so they are set
+	// explicitly rather than left to database/sql
+	MaxOpenConns    int           `yaml:"max_open_conns" env:"DATABASE_MAX_OPEN_CONNS"`         // 0 is unlimited
+	MaxIdleConns    int           `yaml:"max_idle_conns" env:"DATABASE_MAX_IDLE_CONNS"`         // 0 keeps none idle
+	ConnMaxLifetime time.Duration `yaml:"conn_max_lifetime" env:"DATABASE_CONN_MAX_LIFETIME"`   // 0 reuses connections forever
+	ConnMaxIdleTime time.Duration `yaml:"conn_max_idle_time" env:"DATABASE_CONN_MAX_IDLE_TIME"` // 0 keeps idle connections forever
+}
+
+// Limits bound what a client may send.
+type Limits struct {
+	BodyLimit      string  `yaml:"body_limit" env:"BODY_LIMIT"`             // Request body limit, e.g. 20K
+	BatchBodyLimit string  `yaml:"batch_body_limit" env:"BATCH_BODY_LIMIT"` // Body limit of the bulk routes
+	BatchMaxItems  int     `yaml:"batch_max_items" env:"BATCH_MAX_ITEMS"`   // Items of one bulk request
+	RateLimit      float64 `yaml:"rate_limit" env:"RATE_LIMIT"`             // Requests per second per client; 0 disables
+}
+
+// Metrics controls what is recorded next to each request.
+type Metrics struct {
+	ExplainAnalyze     bool          `yaml:"explain_analyze" env:"EXPLAIN_ANALYZE"`           // Capture query plans of every request
+	PoolSampleInterval time.Duration `yaml:"pool_sample_interval" env:"POOL_SAMPLE_INTERVAL"` // How often pool stats are recorded; 0 disables
+}
+
+// Search selects how GetUsers matches its search term.
+type Search struct {
+	Mode string `yaml:"mode" env:"SEARCH_MODE"`
+}
+
+// Storage selects the tables the time-ordered ID types use.
+type Storage struct {
+	Partitioned bool `yaml:"partitioned" env:"PARTITIONED_STORAGE"`
+}
+
+// Debug enables diagnostics that should not be public by default.
+type Debug struct {
+	Pprof bool `yaml:"pprof" env:"PPROF"` // Serve net/http/pprof under /debug/pprof
+}
+
+// Default returns the configuration used for anything not set elsewhere.
+func Default() *Config {
+	return &Config{
+		Server: Server{Port: 3000, GRPCPort: 50051, DrainTimeout: 30 * time.Second},
+		Database: Database{
+			Host:    "localhost",
+			Port:    5432,
+			SSLMode: "disable",
+
+			MaxOpenConns:    20,
+			MaxIdleConns:    10,
+			ConnMaxLifetime: 30 * time.Minute,
+			ConnMaxIdleTime: 5 * time.Minute,
+		},
+		Limits: Limits{
+			BodyLimit:      "20K",
+			BatchBodyLimit: "2M",
+			BatchMaxItems:  500,
+			RateLimit:      10,
+		},
+		Metrics: Metrics{PoolSampleInterval: 10 * time.Second},
+		Search:  Search{Mode: SearchModeILike},
+	}
+}
+
+// Address is the host:port the server listens on.
+func (s Server) Address() string {
+	return net.JoinHostPort(s.Host, strconv.Itoa(s.Port))
+}
+
+// GRPCAddress is the host:port the gRPC server listens on.
+func (s Server) GRPCAddress() string {
+	return net.JoinHostPort(s.Host, strconv.Itoa(s.GRPCPort))
+}
+
+// DSN returns URL, or a key/value connection string built from the parts.
+func (d Database) DSN() string {
+	if d.URL != "" {
+		return d.URL
+	}
+	return fmt.Sprintf("host=%s port=%d user=%s password=%s dbname=%s sslmode=%s TimeZone=UTC",
+		d.Host, d.Port, d.User, d.Password, d.Name, d.SSLMode)
+}
+
+// redactedPassword replaces passwords in Redacted, like url.URL.Redacted.
+const redactedPassword = "xxxxx"
+
+// keyValuePassword matches the password of a key/value connection string.
+var keyValuePassword = regexp.MustCompile(`(password=)('(?:[^'\\]|\\.)*'|\S*)`)
+
+// Redacted returns a copy of d without its password, for display.
+func (d Database) Redacted() Database {
+	if d.Password != "" {
+		d.Password = redactedPassword
+	}
+	if u, err := url.Parse(d.URL); err == nil && u.Scheme != "" {
+		d.URL = u.Redacted()
+	} else {
+		d.URL = keyValuePassword.ReplaceAllString(d.URL, "${1}"+redactedPassword)
+	}
+	return d
+}
+
+// Redacted returns a copy of c without secrets, for display.
+func (c *Config) Redacted() *Config {
+	redacted := *c
+	redacted.Server.AllowedOrigins = append([]string(nil), c.Server.AllowedOrigins...)
+	redacted.Database = c.Database.Redacted()
+	return &redacted
+}
+
+// Summary returns the redacted configuration keyed like the config file.
+func (c *Config) Summary() map[string]interface{} {
+	summary := map[string]interface{}{}
+	data, err := yaml.Marshal(c.Redacted())
+	if err == nil {
+		err = yaml.Unmarshal(data, &summary)
+	}
+	if err != nil {
+		return map[string]interface{}{"error": err.Error()}
+	}
+	return summary
+}
+
+var sslModes = map[string]bool{
+	"disable": true, "allow": true, "prefer": true, "require": true, "verify-ca": true, "verify-full": true,
+}
+
+// Validate returns every invalid setting, named by its config file key.
+func (c *Config) Validate() error {
+	var errs []error
+	invalid := func(key, format string, args ...interface{}) {
+		errs = append(errs, fmt.Errorf("%s %s", key, fmt.Sprintf(format, args...)))
+	}
+
+	if c.Server.Port < 1 || c.Server.Port > 65535 {
+		invalid("server.port", "must be between 1 and 65535, got %d", c.Server.Port)
+	}
+	if c.Server.GRPCPort < 0 || c.Server.GRPCPort > 65535 {
+		invalid("server.grpc_port", "must be between 0 and 65535, got %d", c.Server.GRPCPort)
+	} else if c.Server.GRPCPort != 0 && c.Server.GRPCPort == c.Server.Port {
+		invalid("server.grpc_port", "must differ from server.port (%d)", c.Server.Port)
+	}
+	if c.Server.DrainDelay < 0 {
+		invalid("server.drain_delay", "must not be negative, got %s", c.Server.DrainDelay)
+	}
+	if c.Server.DrainTimeout <= 0 {
+		invalid("server.drain_timeout", "must be positive, got %s", c.Server.DrainTimeout)
+	}
+	for _, origin := range c.Server.AllowedOrigins {
+		if origin == "" {
+			invalid("server.allowed_origins", "must not contain empty origins")
+			break
+		}
+	}
+
+	if c.Database.URL == "" {
+		if c.Database.Host == "" {
+			invalid("database.host", "is required unless database.url is set")
+		}
+		if c.Database.Port < 1 || c.Database.Port > 65535 {
+			invalid("database.port", "must be between 1 and 65535, got %d", c.Database.Port)
+		}
+		if !sslModes[c.Database.SSLMode] {
+			invalid("database.sslmode", "must be one of disable, allow, prefer, require, verify-ca or verify-full, got %q", c.Database.SSLMode)
+		}
+	}
+
+	if c.Database.MaxOpenConns < 0 {
+		invalid("database.max_open_conns", "must not be negative, got %d", c.Database.MaxOpenConns)
+	}
+	if c.Database.MaxIdleConns < 0 {
+		invalid("database.max_idle_conns", "must not be negative, got %d", c.Database.MaxIdleConns)
+	} else if c.Database.MaxOpenConns > 0 && c.Database.MaxIdleConns > c.Database.MaxOpenConns {
+		invalid("database.max_idle_conns", "must not exceed database.max_open_conns (%d), got %d", c.Database.MaxOpenConns, c.Database.MaxIdleConns)
+	}
+	if c.Database.ConnMaxLifetime < 0 {
+		invalid("database.conn_max_lifetime", "must not be negative, got %s", c.Database.ConnMaxLifetime)
+	}
+	if c.Database.ConnMaxIdleTime < 0 {
+		invalid("database.conn_max_idle_time", "must not be negative, got %s", c.Database.ConnMaxIdleTime)
+	}
+
+	if _, err := bytes.Parse(c.Limits.BodyLimit); err != nil {
+		invalid("limits.body_limit", "must be a size such as 20K, got %q", c.Limits.BodyLimit)
+	}
+	if _, err := bytes.Parse(c.Limits.BatchBodyLimit); err != nil {
+		invalid("limits.batch_body_limit", "must be a size such as 2M, got %q", c.Limits.BatchBodyLimit)
+	}
+	if c.Limits.BatchMaxItems < 1 {
+		invalid("limits.batch_max_items", "must be at least 1, got %d", c.Limits.BatchMaxItems)
+	}
+	if c.Limits.RateLimit < 0 {
+		invalid("limits.rate_limit", "must not be negative, got %g", c.Limits.RateLimit)
+	}
+
+	if c.Metrics.PoolSampleInterval < 0 {
+		invalid("metrics.pool_sample_interval", "must not be negative, got %s", c.Metrics.PoolSampleInterval)
+	}
+
+	switch c.Search.Mode {
+	case SearchModeILike, SearchModeTrigram, SearchModeFullText:
+	default:
+		invalid("search.mode", "must be ilike, trigram or fulltext, got %q", c.Search.Mode)
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("invalid configuration: %w", errors.Join(errs...))
+	}
+	return nil
+}
+
+// lookupEnv is os.LookupEnv, treating empty variables as unset as the
+// environment did before there was a config file.
+func lookupEnv(name string) (string, bool) {
+	value, ok := os.LookupEnv(name)
+	return value, ok && value != ""
+}
@@ -0,0 +1,155 @@
+package config
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/pflag"
+	"gopkg.in/yaml.v3"
+)
+
+// Load builds the configuration from, in increasing precedence, the defaults,
+// the YAML file at path (or CONFIG_FILE when path is empty; no file is fine),
+// environment variables and the flags of flags that were set on the command
+// line, and validates it. flags may be nil.
+func Load(path string, flags *pflag.FlagSet) (*Config, error) {
+	cfg := Default()
+
+	if path == "" {
+		path, _ = lookupEnv(EnvConfigFile)
+	}
+	if path != "" {
+		if err := cfg.readFile(path); err != nil {
+			return nil, err
+		}
+	}
+	if err := cfg.applyEnv(); err != nil {
+		return nil, err
+	}
+	if flags != nil {
+		if err := cfg.applyFlags(flags); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	return cfg, nil
+}
+
+// readFile overlays the settings of a YAML file, rejecting unknown keys so a
+// typo does not silently fall back to a default.
+func (c *Config) readFile(path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("read config file: %w", err)
+	}
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+	if err := decoder.Decode(c); err != nil && !errors.Is(err, io.EOF) {
+		return fmt.Errorf("parse config file %s: %w", path, err)
+	}
+	return nil
+}
+
+// applyEnv overlays every field whose env variable is set.
+func (c *Config) applyEnv() error {
+	return c.each("env", func(name string, field reflect.Value) error {
+		value, ok := lookupEnv(name)
+		if !ok {
+			return nil
+		}
+		if err := setField(field, value); err != nil {
+			return fmt.Errorf("environment variable %s: %w", name, err)
+		}
+		return nil
+	})
+}
+
+// applyFlags overlays every field whose flag exists in flags and was given.
+func (c *Config) applyFlags(flags *pflag.FlagSet) error {
+	return c.each("flag", func(name string, field reflect.Value) error {
+		flag := flags.Lookup(name)
+		if flag == nil || !flag.Changed {
+			return nil
+		}
+		if err := setField(field, flag.Value.String()); err != nil {
+			return fmt.Errorf("flag --%s: %w", name, err)
+		}
+		return nil
+	})
+}
+
+// each calls fn with the tag value and field of every section field that has
+// tag.
+func (c *Config) each(tag string, fn func(name string, field reflect.Value) error) error {
+	sections := reflect.ValueOf(c).Elem()
+	for i := 0; i < sections.NumField(); i++ {
+		section := sections.Field(i)
+		for j := 0; j < section.NumField(); j++ {
+			name := section.Type().Field(j).Tag.Get(tag)
+			if name == "" {
+				continue
+			}
+			if err := fn(name, section.Field(j)); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// setField parses value into field by the field's kind. Lists are comma
+// separated.
+func setField(field reflect.Value, value string) error {
+	if field.Type() == reflect.TypeOf(time.Duration(0)) {
+		d, err := time.ParseDuration(value)
+		if err != nil {
+			return fmt.Errorf("%q is not a duration such as 30s or 5m", value)
+		}
+		field.SetInt(int64(d))
+		return nil
+	}
+
+	switch field.Kind() {
+	case reflect.String:
+		field.SetString(value)
+	case reflect.Int:
+		n, err := strconv.Atoi(value)
+		if err != nil {
+			return fmt.Errorf("%q is not a whole number", value)
+		}
+		field.SetInt(int64(n))
+	case reflect.Float64:
+		f, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return fmt.Errorf("%q is not a number", value)
+		}
+		field.SetFloat(f)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return fmt.Errorf("%q is not true or false", value)
+		}
+		field.SetBool(b)
+	case reflect.Slice:
+		var items []string
+		for _, item := range strings.Split(value, ",") {
+			if item = strings.TrimSpace(item); item != "" {
+				items = append(items, item)
+			}
+		}
+		field.Set(reflect.ValueOf(items))
+	default:
+		return fmt.Errorf("unsupported setting type %s", field.Type())
+	}
+	return nil
+}
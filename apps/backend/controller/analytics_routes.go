@@ -89,7 +89,7 @@ func (ac *AnalyticsController) GetPercentiles(c echo.Context) error {
 // @Param type path string true "ID Type" Enums(uuid, ulid, ksuid, cuid, nanoid, snowflake)
 // @Success 200 {array} stats.PercentileTrend
 // @Failure 500 {object} map[string]string
-// @Router /analytics/timeseries/{type} [get]
+// @Router /analytics/trend/{type} [get]
 func (ac *AnalyticsController) GetIdDurationTrend(c echo.Context) error {
 	idType := c.Param("type")
 
@@ -109,7 +109,7 @@ func (ac *AnalyticsController) GetIdDurationTrend(c echo.Context) error {
 // @Param type path string true "ID Type" Enums(uuid, ulid, ksuid, cuid, nanoid, snowflake)
 // @Success 200 {array} stats.TimeSeriesPoint
 // @Failure 500 {object} map[string]string
-// @Router /analytics/{type}/errors [get]
+// @Router /analytics/errors/{type} [get]
 func (ac *AnalyticsController) GetErrorRateTrend(c echo.Context) error {
 	idType := c.Param("type")
 
@@ -128,7 +128,7 @@ func (ac *AnalyticsController) GetErrorRateTrend(c echo.Context) error {
 // @Produce json
 // @Success 200 {array} stats.TableSize
 // @Failure 500 {object} map[string]string
-// @Router /analytics/table-sizes [get]
+// @Router /analytics/tableSize [get]
 func (ac *AnalyticsController) GetTableSizeData(c echo.Context) error {
 	results, err := ac.Repo.GetSpecificTableSizes()
 	if err != nil {
@@ -145,7 +145,7 @@ func (ac *AnalyticsController) GetTableSizeData(c echo.Context) error {
 // @Produce json
 // @Success 200 {array} stats.IDEfficiency
 // @Failure 500 {object} map[string]string
-// @Router /analytics/efficiency [get]
+// @Router /analytics/idEfficiency [get]
 func (ac *AnalyticsController) GetIdEfficiencyMetrics(c echo.Context) error {
 	results, err := ac.Repo.GetIdEfficiencyMetrics()
 	if err != nil {
@@ -153,3 +153,202 @@ func (ac *AnalyticsController) GetIdEfficiencyMetrics(c echo.Context) error {
 	}
 	return c.JSON(http.StatusOK, results)
 }
+
+// GetWriteAmplification godoc
+// @Summary Get write amplification metrics
+// @Description Returns WAL bytes per insert, index blocks touched and buffer hit ratio from the latest write benchmark of each ID type
+// @Tags Analytics
+// @Accept json
+// @Produce json
+// @Success 200 {array} stats.WriteAmplification
+// @Failure 500 {object} map[string]string
+// @Router /analytics/writeAmplification [get]
+func (ac *AnalyticsController) GetWriteAmplification(c echo.Context) error {
+	results, err := ac.Repo.GetWriteAmplification()
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+	return c.JSON(http.StatusOK, results)
+}
+
+// GetQueryPlanStats godoc
+// @Summary Get query plan summary
+// @Description Returns index vs sequential scan counts and buffer usage of captured EXPLAIN ANALYZE plans for a specific ID type
+// @Tags Analytics
+// @Accept json
+// @Produce json
+// @Param type path string true "ID Type" Enums(UUID, ULID, KSUID, CUID, NanoID, Snowflake)
+// @Success 200 {array} stats.QueryPlanStats
+// @Failure 500 {object} map[string]string
+// @Router /analytics/plans/{type} [get]
+func (ac *AnalyticsController) GetQueryPlanStats(c echo.Context) error {
+	idType := c.Param("type")
+
+	results, err := ac.Repo.GetQueryPlanStats(idType)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+	return c.JSON(http.StatusOK, results)
+}
+
+// GetRecentQueryPlans godoc
+// @Summary Get captured query plans
+// @Description Returns the most recent EXPLAIN ANALYZE plans captured for a specific ID type
+// @Tags Analytics
+// @Accept json
+// @Produce json
+// @Param type path string true "ID Type" Enums(UUID, ULID, KSUID, CUID, NanoID, Snowflake)
+// @Param limit query int false "Number of plans to return" default(20)
+// @Success 200 {array} models.QueryPlan
+// @Failure 500 {object} map[string]string
+// @Router /analytics/plans/{type}/recent [get]
+func (ac *AnalyticsController) GetRecentQueryPlans(c echo.Context) error {
+	idType := c.Param("type")
+	limit, _ := strconv.Atoi(c.QueryParam("limit"))
+	if limit <= 0 {
+		limit = 20
+	}
+
+	results, err := ac.Repo.GetRecentQueryPlans(idType, limit)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+	return c.JSON(http.StatusOK, results)
+}
+
+// GetForeignKeyCost godoc
+// @Summary Get foreign key cost
+// @Description Returns the size of each orders.user_id index and the latency of the users-orders join per ID type
+// @Tags Analytics
+// @Accept json
+// @Produce json
+// @Success 200 {array} stats.ForeignKeyCost
+// @Failure 500 {object} map[string]string
+// @Router /analytics/foreignKeys [get]
+func (ac *AnalyticsController) GetForeignKeyCost(c echo.Context) error {
+	results, err := ac.Repo.GetForeignKeyCost()
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+	return c.JSON(http.StatusOK, results)
+}
+
+// GetPartitionPruning godoc
+// @Summary Get partition pruning effectiveness
+// @Description Compares a recent time-window scan on each partitioned users table with the same scan on the unpartitioned table
+// @Tags Analytics
+// @Accept json
+// @Produce json
+// @Param hours query int false "Size of the time window in hours" default(24)
+// @Success 200 {array} stats.PartitionPruning
+// @Failure 500 {object} map[string]string
+// @Router /analytics/partitions [get]
+func (ac *AnalyticsController) GetPartitionPruning(c echo.Context) error {
+	hours, _ := strconv.Atoi(c.QueryParam("hours"))
+	if hours == 0 {
+		hours = 24
+	}
+
+	results, err := ac.Repo.GetPartitionPruning(hours)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+	return c.JSON(http.StatusOK, results)
+}
+
+// GetSearchPerformance godoc
+// @Summary Get search latency by search mode
+// @Description Returns latency of searched list requests grouped by ID type and search mode (ilike, trigram, fulltext)
+// @Tags Analytics
+// @Accept json
+// @Produce json
+// @Success 200 {array} stats.SearchPerformance
+// @Failure 500 {object} map[string]string
+// @Router /analytics/search [get]
+func (ac *AnalyticsController) GetSearchPerformance(c echo.Context) error {
+	results, err := ac.Repo.GetSearchPerformance()
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+	return c.JSON(http.StatusOK, results)
+}
+
+// GetConditionalWrites godoc
+// @Summary Get conditional write cost
+// @Description Returns PUT and PATCH latency and 412 counts grouped by ID type, method and whether If-Match was sent
+// @Tags Analytics
+// @Accept json
+// @Produce json
+// @Success 200 {array} stats.ConditionalWrites
+// @Failure 500 {object} map[string]string
+// @Router /analytics/conditionalWrites [get]
+func (ac *AnalyticsController) GetConditionalWrites(c echo.Context) error {
+	results, err := ac.Repo.GetConditionalWrites()
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+	return c.JSON(http.StatusOK, results)
+}
+
+// GetProtocolComparison godoc
+// @Summary Get latency by protocol
+// @Description Returns latency, errors and response size grouped by ID type and the protocol requests arrived over, http or grpc
+// @Tags Analytics
+// @Accept json
+// @Produce json
+// @Success 200 {array} stats.ProtocolComparison
+// @Failure 500 {object} map[string]string
+// @Router /analytics/protocols [get]
+func (ac *AnalyticsController) GetProtocolComparison(c echo.Context) error {
+	results, err := ac.Repo.GetProtocolComparison()
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+	return c.JSON(http.StatusOK, results)
+}
+
+// GetPoolSaturation godoc
+// @Summary Get connection pool saturation
+// @Description Returns latency per ID type next to the connection pool state each request met, split by whether the pool made callers wait
+// @Tags Analytics
+// @Accept json
+// @Produce json
+// @Param hours query int false "Size of the time window in hours" default(24)
+// @Success 200 {array} stats.PoolSaturation
+// @Failure 500 {object} map[string]string
+// @Router /analytics/pool [get]
+func (ac *AnalyticsController) GetPoolSaturation(c echo.Context) error {
+	hours, _ := strconv.Atoi(c.QueryParam("hours"))
+	if hours == 0 {
+		hours = 24
+	}
+
+	results, err := ac.Repo.GetPoolSaturation(hours)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+	return c.JSON(http.StatusOK, results)
+}
+
+// GetPoolTimeline godoc
+// @Summary Get connection pool timeline
+// @Description Returns the sampled connection pool usage and waits per minute next to the latency of the requests served in that minute
+// @Tags Analytics
+// @Accept json
+// @Produce json
+// @Param hours query int false "Size of the time window in hours" default(24)
+// @Success 200 {array} stats.PoolTimelinePoint
+// @Failure 500 {object} map[string]string
+// @Router /analytics/pool/timeline [get]
+func (ac *AnalyticsController) GetPoolTimeline(c echo.Context) error {
+	hours, _ := strconv.Atoi(c.QueryParam("hours"))
+	if hours == 0 {
+		hours = 24
+	}
+
+	results, err := ac.Repo.GetPoolTimeline(hours)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+	return c.JSON(http.StatusOK, results)
+}
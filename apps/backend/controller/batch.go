@@ -0,0 +1,227 @@
+package controller
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+	"github.com/theCompanyDream/id-trials/apps/backend/config"
+	appMiddleware "github.com/theCompanyDream/id-trials/apps/backend/middleware"
+	model "github.com/theCompanyDream/id-trials/apps/backend/models"
+	repo "github.com/theCompanyDream/id-trials/apps/backend/repository"
+)
+
+// batchMaxItems caps the items of one bulk request, set by Configure.
+var batchMaxItems = config.Default().Limits.BatchMaxItems
+
+// Configure applies the request limits of cfg to every controller.
+func Configure(cfg *config.Config) {
+	batchMaxItems = cfg.Limits.BatchMaxItems
+}
+
+// BatchMaxItems returns the largest number of items a bulk request may carry.
+func BatchMaxItems() int {
+	return batchMaxItems
+}
+
+// isBatchRoute skips the global body limit for bulk routes, which set their own.
+func isBatchRoute(c echo.Context) bool {
+	return strings.HasSuffix(c.Path(), "/batch") || strings.HasSuffix(c.Path(), "/batch/get")
+}
+
+// checkBatchSize rejects empty bulk requests and those over the configured maximum.
+func checkBatchSize(field string, size int) error {
+	if size == 0 {
+		return repo.Validation(map[string]string{field: "must contain at least one item"})
+	}
+	if limit := BatchMaxItems(); size > limit {
+		return repo.Validation(map[string]string{field: fmt.Sprintf("must contain at most %d items", limit)})
+	}
+	return nil
+}
+
+// userBatch implements the bulk routes once for every ID type.
+type userBatch[T any] struct {
+	repo   repo.IRepository[T]
+	idTag  string
+	toUser func(model.UserInput) *T
+	toDTO  func(*T) *model.UserDTO
+}
+
+func failedItem(index int, id string, err error) model.BatchItemResult {
+	problem := appMiddleware.NewProblem(err)
+	return model.BatchItemResult{Index: index, ID: id, Status: problem.Status, Error: &problem}
+}
+
+// respondBatch answers with status when every item succeeded and 207 Multi-Status otherwise.
+func respondBatch(c echo.Context, status int, results []model.BatchItemResult) error {
+	response := model.BatchResponse{Results: results}
+	for _, result := range results {
+		if result.Error != nil {
+			response.Failed++
+		} else {
+			response.Succeeded++
+		}
+	}
+	if response.Failed > 0 {
+		status = http.StatusMultiStatus
+	}
+	return c.JSON(status, response)
+}
+
+// prefixFields keys per-item field errors by their position, e.g. users[3].Email.
+func prefixFields(into map[string]string, prefix string, index int, fields map[string]string) {
+	for field, message := range fields {
+		into[fmt.Sprintf("%s[%d].%s", prefix, index, field)] = message
+	}
+}
+
+func (b userBatch[T]) validateInput(input model.UserInput) map[string]string {
+	if err := validate.Struct(input); err != nil {
+		return validationErrorsToMap(err.(validator.ValidationErrors))
+	}
+	if input.Id != nil {
+		return validateID("id", *input.Id, b.idTag)
+	}
+	return nil
+}
+
+// validIDs splits ids into valid ones and per-item failures. An atomic request
+// fails as a whole on the first invalid ID.
+func (b userBatch[T]) validIDs(ids []string, atomic bool, results []model.BatchItemResult) ([]string, error) {
+	valid := make([]string, 0, len(ids))
+	invalid := make(map[string]string)
+	for i, id := range ids {
+		if errs := validateID("id", id, b.idTag); errs != nil {
+			prefixFields(invalid, "ids", i, errs)
+			results[i] = failedItem(i, id, repo.InvalidID(errs))
+			continue
+		}
+		valid = append(valid, id)
+	}
+	if atomic && len(invalid) > 0 {
+		return nil, repo.InvalidID(invalid)
+	}
+	return valid, nil
+}
+
+func (b userBatch[T]) create(c echo.Context) error {
+	request := model.BatchCreateRequest{}
+	if err := c.Bind(&request); err != nil {
+		return err
+	}
+	if err := checkBatchSize("users", len(request.Users)); err != nil {
+		return err
+	}
+
+	results := make([]model.BatchItemResult, len(request.Users))
+	users := make([]T, 0, len(request.Users))
+	positions := make([]int, 0, len(request.Users))
+	invalid := make(map[string]string)
+	for i, input := range request.Users {
+		if errs := b.validateInput(input); errs != nil {
+			prefixFields(invalid, "users", i, errs)
+			results[i] = failedItem(i, "", repo.Validation(errs))
+			continue
+		}
+		users = append(users, *b.toUser(input))
+		positions = append(positions, i)
+	}
+	if request.Atomic && len(invalid) > 0 {
+		return repo.Validation(invalid)
+	}
+
+	created, err := b.repo.WithContext(c.Request().Context()).CreateUsers(users, request.Atomic)
+	if err != nil {
+		return err
+	}
+	for j, result := range created {
+		i := positions[j]
+		if result.Err != nil {
+			results[i] = failedItem(i, "", result.Err)
+			continue
+		}
+		user := b.toDTO(result.User)
+		results[i] = model.BatchItemResult{Index: i, ID: user.ID, Status: http.StatusCreated, User: user}
+	}
+	return respondBatch(c, http.StatusCreated, results)
+}
+
+func (b userBatch[T]) get(c echo.Context) error {
+	request := model.BatchIDsRequest{}
+	if err := c.Bind(&request); err != nil {
+		return err
+	}
+	if err := checkBatchSize("ids", len(request.IDs)); err != nil {
+		return err
+	}
+
+	results := make([]model.BatchItemResult, len(request.IDs))
+	valid, err := b.validIDs(request.IDs, request.Atomic, results)
+	if err != nil {
+		return err
+	}
+	found, err := b.repo.WithContext(c.Request().Context()).GetUsersByID(valid)
+	if err != nil {
+		return err
+	}
+
+	byID := make(map[string]*model.UserDTO, len(found))
+	for i := range found {
+		user := b.toDTO(&found[i])
+		byID[user.ID] = user
+	}
+	for i, id := range request.IDs {
+		if results[i].Error != nil {
+			continue
+		}
+		user, ok := byID[id]
+		if !ok {
+			if request.Atomic {
+				return repo.NotFound("user", id)
+			}
+			results[i] = failedItem(i, id, repo.NotFound("user", id))
+			continue
+		}
+		results[i] = model.BatchItemResult{Index: i, ID: id, Status: http.StatusOK, User: user}
+	}
+	return respondBatch(c, http.StatusOK, results)
+}
+
+func (b userBatch[T]) delete(c echo.Context) error {
+	request := model.BatchIDsRequest{}
+	if err := c.Bind(&request); err != nil {
+		return err
+	}
+	if err := checkBatchSize("ids", len(request.IDs)); err != nil {
+		return err
+	}
+
+	results := make([]model.BatchItemResult, len(request.IDs))
+	valid, err := b.validIDs(request.IDs, request.Atomic, results)
+	if err != nil {
+		return err
+	}
+	deleted, err := b.repo.WithContext(c.Request().Context()).DeleteUsers(valid, request.Atomic)
+	if err != nil {
+		return err
+	}
+
+	gone := make(map[string]bool, len(deleted))
+	for _, id := range deleted {
+		gone[id] = true
+	}
+	for i, id := range request.IDs {
+		if results[i].Error != nil {
+			continue
+		}
+		if !gone[id] {
+			results[i] = failedItem(i, id, repo.NotFound("user", id))
+			continue
+		}
+		results[i] = model.BatchItemResult{Index: i, ID: id, Status: http.StatusOK}
+	}
+	return respondBatch(c, http.StatusOK, results)
+}
@@ -1,182 +1,189 @@
 package controller
 
 import (
-	"fmt"
-	"os"
-	"strings"
+	"time"
 
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/echo/v4/middleware"
 	echoSwagger "github.com/swaggo/echo-swagger"
+	"github.com/theCompanyDream/id-trials/apps/backend/config"
 	appMiddleware "github.com/theCompanyDream/id-trials/apps/backend/middleware"
 	"golang.org/x/time/rate"
+	"google.golang.org/grpc/health"
 	"gorm.io/gorm"
 )
 
-func RunServer(db *gorm.DB) {
-	server := NewEchoServer(db)
-	// Start the server
-	server.Logger.Info("Server is running...")
-	port := os.Getenv("BACKEND_PORT")
-	if port != "" {
-		serverStartCode := fmt.Sprintf(":%s", port)
-		server.Logger.Fatal(server.Start(serverStartCode))
-	} else {
-		server.Logger.Fatal(server.Start(":3000"))
+// useLimits adds the body limit, rate limit and CORS middleware of cfg.
+func useLimits(server *echo.Echo, cfg *config.Config) {
+	server.Use(middleware.BodyLimitWithConfig(middleware.BodyLimitConfig{Limit: cfg.Limits.BodyLimit, Skipper: isBatchRoute}))
+	if cfg.Limits.RateLimit > 0 {
+		server.Use(middleware.RateLimiter(middleware.NewRateLimiterMemoryStore(rate.Limit(cfg.Limits.RateLimit))))
 	}
+	// Echo allows every origin when given none, so leave CORS off instead
+	if len(cfg.Server.AllowedOrigins) > 0 {
+		server.Use(middleware.CORSWithConfig(middleware.CORSConfig{
+			AllowOrigins: cfg.Server.AllowedOrigins,
+			AllowHeaders: []string{echo.HeaderOrigin, echo.HeaderContentType, echo.HeaderAccept, appMiddleware.HeaderExplainAnalyze,
+				appMiddleware.HeaderIfMatch, appMiddleware.HeaderIfNoneMatch},
+			ExposeHeaders: []string{appMiddleware.HeaderETag, appMiddleware.HeaderDeprecation, appMiddleware.HeaderLink},
+		}))
+	}
+}
+
+// ServerOptions are what differs between the long-running server and the
+// serverless handler.
+type ServerOptions struct {
+	// Prefix of the unversioned routes, e.g. /api where Vercel forwards
+	// /api/* unchanged
+	Prefix string
 }
 
-func NewEchoServer(db *gorm.DB) *echo.Echo {
+// NewServer builds the API server: the shared middleware, the unversioned
+// routes under opts.Prefix, the versioned API under APIPrefix and the gRPC
+// services, which record their metrics through the same middleware.
+func NewServer(db *gorm.DB, cfg *config.Config, opts ServerOptions) *Server {
+	Configure(cfg)
 	server := echo.New()
 
 	server.HTTPErrorHandler = appMiddleware.HttpErrorHandler
-	metricsMiddleware := appMiddleware.NewMetricsMiddleware(db)
-
-	analyticsController := NewAnalyticsController(db)
-	ulidController := NewUlidController(db)
-	uuid4Controller := NewGormUuidController(db)
-	nanoIdController := NewGormNanoController(db)
-	ksuidController := NewGormKsuidController(db)
-	cuidController := NewGormCuidController(db)
-	snowController := NewSnowCuidController(db)
+	metricsMiddleware := appMiddleware.NewMetricsMiddleware(db, cfg.Metrics.ExplainAnalyze)
+	app := &Server{Echo: server, db: db, cfg: cfg, metrics: metricsMiddleware, grpcHealth: health.NewServer(), started: time.Now()}
+	app.GRPC = newGRPCServer(db, cfg, metricsMiddleware, app.grpcHealth)
 
 	// Middleware
 	server.Use(appMiddleware.LoggingMiddleware)
 	server.Use(middleware.Recover())
 	server.Use(middleware.RequestID())
 	server.Use(middleware.Gzip())
-	server.Use(middleware.BodyLimit("20k"))
-	server.Use(middleware.RateLimiter(middleware.NewRateLimiterMemoryStore(rate.Limit(10))))
-	server.Use(middleware.CORSWithConfig(middleware.CORSConfig{
-		AllowOrigins: strings.Split(os.Getenv("ALLOWED_HOSTS"), ","),
-		AllowHeaders: []string{echo.HeaderOrigin, echo.HeaderContentType, echo.HeaderAccept},
-	}))
+	useLimits(server, cfg)
 	server.Use(middleware.Secure())
 	server.Use(metricsMiddleware.CaptureMetrics())
 
-	server.GET("/analytics/comparison", analyticsController.GetIDTypeComparison)
-	server.GET("/analytics/details/:type", analyticsController.GetIDTypeDetails)
-	server.GET("/analytics/percentiles/:type", analyticsController.GetPercentiles)
-	server.GET("/analytics/errors/:type", analyticsController.GetErrorRateTrend)
-	server.GET("/analytics/trend/:type", analyticsController.GetIdDurationTrend)
-	server.GET("/analytics/tableSize", analyticsController.GetTableSizeData)
-	server.GET("/analytics/idEfficiency", analyticsController.GetIdEfficiencyMetrics)
-	// Define main routes
-	server.GET("/swagger/*", echoSwagger.WrapHandler)
-	server.GET("/", Home)
-	server.GET("/ulidIds", ulidController.GetUsers)
-	server.GET("/ulidId/:id", ulidController.GetUser)
-	server.POST("/ulidId", ulidController.CreateUser)
-	server.PUT("/ulidId/:id", ulidController.UpdateUser)
-	server.DELETE("/ulidId/:id", ulidController.DeleteUser)
-	//uuid
-	server.GET("/uuid4s", uuid4Controller.GetUsers)
-	server.GET("/uuid4/:id", uuid4Controller.GetUser)
-	server.POST("/uuid4", uuid4Controller.CreateUser)
-	server.PUT("/uuid4/:id", uuid4Controller.UpdateUser)
-	server.DELETE("/uuid4/:id", uuid4Controller.DeleteUser)
-	//nanoId
-	server.GET("/nanoIds", nanoIdController.GetUsers)
-	server.GET("/nanoId/:id", nanoIdController.GetUser)
-	server.POST("/nanoId", nanoIdController.CreateUser)
-	server.PUT("/nanoId/:id", nanoIdController.UpdateUser)
-	server.DELETE("/nanoId/:id", nanoIdController.DeleteUser)
-	//ksuidId
-	server.GET("/ksuidIds", ksuidController.GetUsers)
-	server.GET("/ksuidId/:id", ksuidController.GetUser)
-	server.POST("/ksuidId", ksuidController.CreateUser)
-	server.PUT("/ksuidId/:id", ksuidController.UpdateUser)
-	server.DELETE("/ksuidId/:id", ksuidController.DeleteUser)
-	//cuid
-	server.GET("/cuidIds", cuidController.GetUsers)
-	server.GET("/cuidId/:id", cuidController.GetUser)
-	server.POST("/cuidId", cuidController.CreateUser)
-	server.PUT("/cuidId/:id", cuidController.UpdateUser)
-	server.DELETE("/cuidId/:id", cuidController.DeleteUser)
-
-	server.GET("/snowIds", snowController.GetUsers)
-	server.GET("/snowId/:id", snowController.GetUser)
-	server.POST("/snowId", snowController.CreateUser)
-	server.PUT("/snowId/:id", snowController.UpdateUser)
-	server.DELETE("/snowId/:id", snowController.DeleteUser)
-
-	return server
+	app.RegisterRoutes(server.Group(opts.Prefix))
+	app.RegisterV1Routes(server.Group(APIPrefix))
+	return app
 }
 
-func NewServerlessEchoServer(db *gorm.DB) *echo.Echo {
-	server := echo.New()
+// NewEchoServer is the long-running server, serving from the root.
+func NewEchoServer(db *gorm.DB, cfg *config.Config) *Server {
+	return NewServer(db, cfg, ServerOptions{})
+}
 
-	metricsMiddleware := appMiddleware.NewMetricsMiddleware(db)
+// NewServerlessEchoServer is the Vercel handler's server, serving under /api.
+func NewServerlessEchoServer(db *gorm.DB, cfg *config.Config) *echo.Echo {
+	return NewServer(db, cfg, ServerOptions{Prefix: "/api"}).Echo
+}
 
-	analyticsController := NewAnalyticsController(db)
-	ulidController := NewUlidController(db)
-	uuid4Controller := NewGormUuidController(db)
-	nanoIdController := NewGormNanoController(db)
-	ksuidController := NewGormKsuidController(db)
-	cuidController := NewGormCuidController(db)
-	snowController := NewSnowCuidController(db)
+// APIPrefix is where the versioned API is served, by both servers.
+const APIPrefix = "/api/v1"
 
-	// Middleware
-	server.Use(middleware.Recover())
-	server.Use(middleware.RequestID())
-	server.Use(middleware.RequestLogger())
-	server.Use(middleware.Gzip())
-	server.Use(middleware.BodyLimit("20k"))
-	server.Use(middleware.RateLimiter(middleware.NewRateLimiterMemoryStore(rate.Limit(10))))
-	server.Use(middleware.CORSWithConfig(middleware.CORSConfig{
-		AllowOrigins: strings.Split(os.Getenv("ALLOWED_HOSTS"), ","),
-		AllowHeaders: []string{echo.HeaderOrigin, echo.HeaderContentType, echo.HeaderAccept},
-	}))
-	server.Use(middleware.Secure())
-	server.Use(metricsMiddleware.CaptureMetrics())
+// LegacyDeprecated is when the unversioned ID type routes were deprecated in
+// favour of those under APIPrefix.
+var LegacyDeprecated = time.Date(2026, time.October, 19, 0, 0, 0, 0, time.UTC)
+
+// idType is an ID type's controllers, the slug of its versioned routes and
+// the names of its legacy routes.
+type idType struct {
+	slug   string
+	users  IUserController
+	orders IOrderController
+
+	// Legacy names, e.g. /ulidId/:id, /ulidIds and /ulidOrder/:id
+	user, list, order string
+}
 
-	api := server.Group("/api")
+// idTypeRoutes returns every ID type, slugs as the analytics routes take them.
+func (s *Server) idTypeRoutes() []idType {
+	return []idType{
+		{slug: "ulid", users: NewUlidController(s.db), orders: ordersFor("ULID", NewUlidOrderController(s.db)), user: "ulidId", list: "ulidIds", order: "ulidOrder"},
+		{slug: "uuid", users: NewGormUuidController(s.db), orders: ordersFor("UUID", NewGormUuidOrderController(s.db)), user: "uuid4", list: "uuid4s", order: "uuid4Order"},
+		{slug: "nanoid", users: NewGormNanoController(s.db), orders: ordersFor("NanoID", NewGormNanoOrderController(s.db)), user: "nanoId", list: "nanoIds", order: "nanoOrder"},
+		{slug: "ksuid", users: NewGormKsuidController(s.db), orders: ordersFor("KSUID", NewGormKsuidOrderController(s.db)), user: "ksuidId", list: "ksuidIds", order: "ksuidOrder"},
+		{slug: "cuid", users: NewGormCuidController(s.db), orders: ordersFor("CUID", NewGormCuidOrderController(s.db)), user: "cuidId", list: "cuidIds", order: "cuidOrder"},
+		{slug: "snowflake", users: NewSnowCuidController(s.db), orders: ordersFor("Snowflake", NewSnowOrderController(s.db)), user: "snowId", list: "snowIds", order: "snowOrder"},
+	}
+}
 
-	api.GET("/analytics/comparison", analyticsController.GetIDTypeComparison)
-	api.GET("/analytics/details/:type", analyticsController.GetIDTypeDetails)
-	api.GET("/analytics/percentiles/:type", analyticsController.GetPercentiles)
-	api.GET("/analytics/errors/:type", analyticsController.GetErrorRateTrend)
-	api.GET("/analytics/trend/:type", analyticsController.GetIdDurationTrend)
-	api.GET("/analytics/tableSize", analyticsController.GetTableSizeData)
-	api.GET("/analytics/idEfficiency", analyticsController.GetIdEfficiencyMetrics)
+// RegisterRoutes adds the unversioned routes to router: analytics, docs,
+// health and the legacy routes of every ID type, which are deprecated aliases
+// of RegisterV1Routes.
+func (s *Server) RegisterRoutes(router *echo.Group) {
+	analyticsController := NewAnalyticsController(s.db)
+
+	router.GET("/analytics/comparison", analyticsController.GetIDTypeComparison)
+	router.GET("/analytics/details/:type", analyticsController.GetIDTypeDetails)
+	router.GET("/analytics/percentiles/:type", analyticsController.GetPercentiles)
+	router.GET("/analytics/errors/:type", analyticsController.GetErrorRateTrend)
+	router.GET("/analytics/trend/:type", analyticsController.GetIdDurationTrend)
+	router.GET("/analytics/tableSize", analyticsController.GetTableSizeData)
+	router.GET("/analytics/idEfficiency", analyticsController.GetIdEfficiencyMetrics)
+	router.GET("/analytics/writeAmplification", analyticsController.GetWriteAmplification)
+	router.GET("/analytics/plans/:type", analyticsController.GetQueryPlanStats)
+	router.GET("/analytics/plans/:type/recent", analyticsController.GetRecentQueryPlans)
+	router.GET("/analytics/foreignKeys", analyticsController.GetForeignKeyCost)
+	router.GET("/analytics/partitions", analyticsController.GetPartitionPruning)
+	router.GET("/analytics/search", analyticsController.GetSearchPerformance)
+	router.GET("/analytics/conditionalWrites", analyticsController.GetConditionalWrites)
+	router.GET("/analytics/protocols", analyticsController.GetProtocolComparison)
+	router.GET("/analytics/pool", analyticsController.GetPoolSaturation)
+	router.GET("/analytics/pool/timeline", analyticsController.GetPoolTimeline)
 	// Define main routes
-	api.GET("/swagger/*", echoSwagger.WrapHandler)
-	api.GET("/", Home)
-	api.GET("/ulidIds", ulidController.GetUsers)
-	api.GET("/ulidId/:id", ulidController.GetUser)
-	api.POST("/ulidId", ulidController.CreateUser)
-	api.PUT("/ulidId/:id", ulidController.UpdateUser)
-	api.DELETE("/ulidId/:id", ulidController.DeleteUser)
-	//uuid
-	api.GET("/uuid4s", uuid4Controller.GetUsers)
-	api.GET("/uuid4/:id", uuid4Controller.GetUser)
-	api.POST("/uuid4", uuid4Controller.CreateUser)
-	api.PUT("/uuid4/:id", uuid4Controller.UpdateUser)
-	api.DELETE("/uuid4/:id", uuid4Controller.DeleteUser)
-	//nanoId
-	api.GET("/nanoIds", nanoIdController.GetUsers)
-	api.GET("/nanoId/:id", nanoIdController.GetUser)
-	api.POST("/nanoId", nanoIdController.CreateUser)
-	api.PUT("/nanoId/:id", nanoIdController.UpdateUser)
-	api.DELETE("/nanoId/:id", nanoIdController.DeleteUser)
-	//ksuidId
-	api.GET("/ksuidIds", ksuidController.GetUsers)
-	api.GET("/ksuidId/:id", ksuidController.GetUser)
-	api.POST("/ksuidId", ksuidController.CreateUser)
-	api.PUT("/ksuidId/:id", ksuidController.UpdateUser)
-	api.DELETE("/ksuidId/:id", ksuidController.DeleteUser)
-	//cuid
-	api.GET("/cuidIds", cuidController.GetUsers)
-	api.GET("/cuidId/:id", cuidController.GetUser)
-	api.POST("/cuidId", cuidController.CreateUser)
-	api.PUT("/cuidId/:id", cuidController.UpdateUser)
-	api.DELETE("/cuidId/:id", cuidController.DeleteUser)
-
-	api.GET("/snowIds", snowController.GetUsers)
-	api.GET("/snowId/:id", snowController.GetUser)
-	api.POST("/snowId", snowController.CreateUser)
-	api.PUT("/snowId/:id", snowController.UpdateUser)
-	api.DELETE("/snowId/:id", snowController.DeleteUser)
-
-	return server
+	router.GET("/swagger/*", echoSwagger.WrapHandler)
+	router.GET("/", Home)
+	router.GET("/healthz", Healthz)
+	router.GET("/readyz", s.Readyz)
+	router.GET("/debug/info", s.DebugInfo)
+	if s.cfg.Debug.Pprof {
+		registerPprof(router)
+	}
+
+	batchLimit := middleware.BodyLimit(s.cfg.Limits.BatchBodyLimit)
+	for _, t := range s.idTypeRoutes() {
+		deprecated := appMiddleware.Deprecated(LegacyDeprecated, APIPrefix+"/ids/"+t.slug+"/users")
+		user, list, order := "/"+t.user, "/"+t.list, "/"+t.order
+
+		router.GET(list, t.users.GetUsers, deprecated)
+		router.GET(user+"/:id", t.users.GetUser, deprecated)
+		router.POST(user, t.users.CreateUser, deprecated)
+		router.PUT(user+"/:id", t.users.UpdateUser, deprecated)
+		router.PATCH(user+"/:id", t.users.PatchUser, deprecated)
+		router.DELETE(user+"/:id", t.users.DeleteUser, deprecated)
+		router.POST(list+"/batch", t.users.CreateUsers, deprecated, batchLimit)
+		router.POST(list+"/batch/get", t.users.GetUsersByID, deprecated, batchLimit)
+		router.DELETE(list+"/batch", t.users.DeleteUsers, deprecated, batchLimit)
+		router.GET(list+"/export", t.users.ExportUsers, deprecated)
+
+		// orders, the child table of each ID type
+		router.GET(user+"/:id/orders", t.orders.GetOrders, deprecated)
+		router.POST(user+"/:id/orders", t.orders.CreateOrder, deprecated)
+		router.GET(order+"/:id", t.orders.GetOrder, deprecated)
+		router.PUT(order+"/:id", t.orders.UpdateOrder, deprecated)
+		router.DELETE(order+"/:id", t.orders.DeleteOrder, deprecated)
+	}
+}
+
+// RegisterV1Routes adds the versioned API to router, the same routes for
+// every ID type under /ids/{type}.
+func (s *Server) RegisterV1Routes(router *echo.Group) {
+	batchLimit := middleware.BodyLimit(s.cfg.Limits.BatchBodyLimit)
+	for _, t := range s.idTypeRoutes() {
+		ids := router.Group("/ids/" + t.slug)
+
+		ids.GET("/users", t.users.GetUsers)
+		ids.POST("/users", t.users.CreateUser)
+		ids.GET("/users/:id", t.users.GetUser)
+		ids.PUT("/users/:id", t.users.UpdateUser)
+		ids.PATCH("/users/:id", t.users.PatchUser)
+		ids.DELETE("/users/:id", t.users.DeleteUser)
+		ids.POST("/users/batch", t.users.CreateUsers, batchLimit)
+		ids.POST("/users/batch/get", t.users.GetUsersByID, batchLimit)
+		ids.DELETE("/users/batch", t.users.DeleteUsers, batchLimit)
+		ids.GET("/users/export", t.users.ExportUsers)
+
+		ids.GET("/users/:id/orders", t.orders.GetOrders)
+		ids.POST("/users/:id/orders", t.orders.CreateOrder)
+		ids.GET("/orders/:id", t.orders.GetOrder)
+		ids.PUT("/orders/:id", t.orders.UpdateOrder)
+		ids.DELETE("/orders/:id", t.orders.DeleteOrder)
+	}
 }
@@ -0,0 +1,65 @@
+package controller
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+	appMiddleware "github.com/theCompanyDream/id-trials/apps/backend/middleware"
+	repo "github.com/theCompanyDream/id-trials/apps/backend/repository"
+)
+
+// etag renders a user version as a strong entity tag.
+func etag(version int64) string {
+	return strconv.Quote(strconv.FormatInt(version, 10))
+}
+
+// etagMatches reports whether an If-Match or If-None-Match header lists the tag
+// of version. If-Match uses the strong comparison, so weak tags never match it.
+func etagMatches(header string, version int64, weak bool) bool {
+	for _, tag := range strings.Split(header, ",") {
+		tag = strings.TrimSpace(tag)
+		if tag == "*" {
+			return true
+		}
+		if strings.HasPrefix(tag, "W/") {
+			if !weak {
+				continue
+			}
+			tag = strings.TrimPrefix(tag, "W/")
+		}
+		if tag == etag(version) {
+			return true
+		}
+	}
+	return false
+}
+
+func setETag(c echo.Context, version int64) {
+	c.Response().Header().Set(appMiddleware.HeaderETag, etag(version))
+}
+
+// notModified sets the ETag of a read and answers 304 when If-None-Match
+// already holds it. It reports whether the response has been written.
+func notModified(c echo.Context, version int64) (bool, error) {
+	setETag(c, version)
+	header := c.Request().Header.Get(appMiddleware.HeaderIfNoneMatch)
+	if header == "" || !etagMatches(header, version, true) {
+		return false, nil
+	}
+	return true, c.NoContent(http.StatusNotModified)
+}
+
+// hasIfMatch reports whether the request makes its write conditional.
+func hasIfMatch(c echo.Context) bool {
+	return c.Request().Header.Get(appMiddleware.HeaderIfMatch) != ""
+}
+
+// checkIfMatch fails a write whose If-Match does not hold the stored version.
+func checkIfMatch(c echo.Context, id string, version int64) error {
+	if hasIfMatch(c) && !etagMatches(c.Request().Header.Get(appMiddleware.HeaderIfMatch), version, false) {
+		return repo.PreconditionFailed("user", id)
+	}
+	return nil
+}
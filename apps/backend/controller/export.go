@@ -0,0 +1,103 @@
+package controller
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+	model "github.com/theCompanyDream/id-trials/apps/backend/models"
+)
+
+// MIMEApplicationNDJSON is newline delimited JSON, one user per line.
+const MIMEApplicationNDJSON = "application/x-ndjson"
+
+// exportFlushEvery is the number of rows written between flushes, so clients
+// see progress while a large table is still being read.
+const exportFlushEvery = 500
+
+var exportCSVHeader = []string{"id", "user_name", "first_name", "last_name", "email", "department", "version"}
+
+// exportFormat picks NDJSON or CSV from the Accept header. NDJSON is the
+// default when the client accepts anything.
+func exportFormat(accept string) (string, bool) {
+	if accept == "" {
+		return MIMEApplicationNDJSON, true
+	}
+	for _, part := range strings.Split(accept, ",") {
+		mediaType := strings.TrimSpace(strings.SplitN(part, ";", 2)[0])
+		switch mediaType {
+		case MIMEApplicationNDJSON, "application/jsonl", "*/*", "application/*":
+			return MIMEApplicationNDJSON, true
+		case "text/csv", "text/*":
+			return "text/csv", true
+		}
+	}
+	return "", false
+}
+
+// streamUsers writes every user export emits in the negotiated format. The
+// status line goes out with the first row, so a query that fails up front
+// still gets a problem response.
+func streamUsers(c echo.Context, export func(search string, emit func(*model.UserDTO) error) error) error {
+	format, ok := exportFormat(c.Request().Header.Get(echo.HeaderAccept))
+	if !ok {
+		return echo.NewHTTPError(http.StatusNotAcceptable, "export is available as "+MIMEApplicationNDJSON+" or text/csv")
+	}
+
+	response := c.Response()
+	encoder := json.NewEncoder(response)
+	writer := csv.NewWriter(response)
+	rows := 0
+
+	begin := func() {
+		response.Header().Set(echo.HeaderContentType, format+"; charset=utf-8")
+		response.WriteHeader(http.StatusOK)
+		if format == "text/csv" {
+			writer.Write(exportCSVHeader)
+		}
+	}
+	flush := func() error {
+		if format == "text/csv" {
+			writer.Flush()
+			if err := writer.Error(); err != nil {
+				return err
+			}
+		}
+		response.Flush()
+		return nil
+	}
+
+	err := export(c.QueryParam("search"), func(user *model.UserDTO) error {
+		if rows == 0 {
+			begin()
+		}
+		var err error
+		if format == "text/csv" {
+			department := ""
+			if user.Department != nil {
+				department = *user.Department
+			}
+			err = writer.Write([]string{user.ID, user.UserName, user.FirstName, user.LastName, user.Email, department, strconv.FormatInt(user.Version, 10)})
+		} else {
+			err = encoder.Encode(user)
+		}
+		if err != nil {
+			return err
+		}
+		rows++
+		if rows%exportFlushEvery == 0 {
+			return flush()
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		begin()
+	}
+	return flush()
+}
@@ -0,0 +1,114 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/labstack/gommon/bytes"
+	"github.com/theCompanyDream/id-trials/apps/backend/config"
+	appMiddleware "github.com/theCompanyDream/id-trials/apps/backend/middleware"
+	model "github.com/theCompanyDream/id-trials/apps/backend/models"
+	pb "github.com/theCompanyDream/id-trials/apps/backend/proto/idtrials/v1"
+	repo "github.com/theCompanyDream/id-trials/apps/backend/repository"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/reflection"
+	"gorm.io/gorm"
+)
+
+//go:generate sh -c "cd .. && buf generate"
+
+// newGRPCServer builds the gRPC server: the user services of every ID type,
+// health and reflection. Errors, request IDs and metrics are handled as the
+// HTTP server handles them, and a message may be as large as a request body.
+func newGRPCServer(db *gorm.DB, cfg *config.Config, metrics *appMiddleware.MetricsMiddleware, healthServer *health.Server) *grpc.Server {
+	options := []grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(
+			appMiddleware.GRPCRequestID,
+			appMiddleware.GRPCErrorHandler,
+			metrics.CaptureGRPCMetrics(),
+		),
+	}
+	// Validate has already rejected a body limit that does not parse
+	if limit, err := bytes.Parse(cfg.Limits.BodyLimit); err == nil && limit > 0 {
+		options = append(options, grpc.MaxRecvMsgSize(int(limit)))
+	}
+	server := grpc.NewServer(options...)
+
+	pb.RegisterUlidUsersServer(server, bytesUsers[model.UserUlid]{
+		grpcUsers: grpcUsers[model.UserUlid]{
+			repo:   repo.NewGormUlidRepository(repo.StorageFor(db, model.UserUlid{}.TableName())),
+			idTag:  "ulid",
+			toUser: model.InputToUlid,
+			toDTO:  (*model.UserUlid).UlidToDTO,
+			base:   func(user *model.UserUlid) *model.UserBase { return user.UserBase },
+		},
+		decode: ulidFromBytes,
+		encode: ulidToBytes,
+	})
+	pb.RegisterUuidUsersServer(server, bytesUsers[model.UserUUID]{
+		grpcUsers: grpcUsers[model.UserUUID]{
+			repo:   repo.NewGormUuidRepository(db),
+			idTag:  "uuid4",
+			toUser: model.InputToUUID,
+			toDTO:  (*model.UserUUID).UuidToDTO,
+			base:   func(user *model.UserUUID) *model.UserBase { return user.UserBase },
+		},
+		decode: uuidFromBytes,
+		encode: uuidToBytes,
+	})
+	pb.RegisterKsuidUsersServer(server, bytesUsers[model.UserKSUID]{
+		grpcUsers: grpcUsers[model.UserKSUID]{
+			repo:   repo.NewGormKsuidRepository(repo.StorageFor(db, model.UserKSUID{}.TableName())),
+			idTag:  "ksuid",
+			toUser: model.InputToKSUID,
+			toDTO:  (*model.UserKSUID).KsuidToDTO,
+			base:   func(user *model.UserKSUID) *model.UserBase { return user.UserBase },
+		},
+		decode: ksuidFromBytes,
+		encode: ksuidToBytes,
+	})
+	pb.RegisterCuidUsersServer(server, stringUsers[model.UserCUID]{grpcUsers[model.UserCUID]{
+		repo:   repo.NewGormCuidRepository(db),
+		idTag:  "cuid2",
+		toUser: model.InputToCuid,
+		toDTO:  (*model.UserCUID).CuidToDTO,
+		base:   func(user *model.UserCUID) *model.UserBase { return user.UserBase },
+	}})
+	pb.RegisterNanoidUsersServer(server, stringUsers[model.UserNanoID]{grpcUsers[model.UserNanoID]{
+		repo:   repo.NewGormNanoIdRepository(db),
+		idTag:  "nanoid",
+		toUser: model.InputToNanoId,
+		toDTO:  (*model.UserNanoID).NanoIdToDTO,
+		base:   func(user *model.UserNanoID) *model.UserBase { return user.UserBase },
+	}})
+	pb.RegisterSnowflakeUsersServer(server, int64Users[model.UserSnowflake]{grpcUsers[model.UserSnowflake]{
+		repo:   repo.NewGormSnowRepository(repo.StorageFor(db, model.UserSnowflake{}.TableName())),
+		idTag:  "snowflake",
+		toUser: model.InputToSnowFlake,
+		toDTO:  (*model.UserSnowflake).SnowflakeToDTO,
+		base:   func(user *model.UserSnowflake) *model.UserBase { return user.UserBase },
+	}})
+
+	healthpb.RegisterHealthServer(server, healthServer)
+	reflection.Register(server)
+	return server
+}
+
+// stopGRPC lets in-flight calls finish until ctx is done, then cancels them.
+func (s *Server) stopGRPC(ctx context.Context) error {
+	stopped := make(chan struct{})
+	go func() {
+		s.GRPC.GracefulStop()
+		close(stopped)
+	}()
+
+	select {
+	case <-stopped:
+		return nil
+	case <-ctx.Done():
+		s.GRPC.Stop()
+		<-stopped
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,355 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	"github.com/oklog/ulid/v2"
+	"github.com/segmentio/ksuid"
+	model "github.com/theCompanyDream/id-trials/apps/backend/models"
+	pb "github.com/theCompanyDream/id-trials/apps/backend/proto/idtrials/v1"
+	repo "github.com/theCompanyDream/id-trials/apps/backend/repository"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// grpcUsers implements the user RPCs once for every ID type, with the
+// repositories and validation of the HTTP routes. IDs are text here, as the
+// repositories store them; bytesUsers, stringUsers and int64Users convert
+// them to and from their representation on the wire.
+type grpcUsers[T any] struct {
+	repo   repo.IRepository[T]
+	idTag  string
+	toUser func(model.UserInput) *T
+	toDTO  func(*T) *model.UserDTO
+	base   func(*T) *model.UserBase
+}
+
+// invalidWireID reports an ID that does not decode, as validateID reports one
+// that does not validate.
+func invalidWireID(tag string) map[string]string {
+	return map[string]string{"id": fmt.Sprintf("Field validation for 'id' failed on the '%s' tag", tag)}
+}
+
+// userInput is the UserInput of fields, which must carry every required field.
+func userInput(fields *pb.UserFields) (model.UserInput, error) {
+	if fields == nil {
+		fields = &pb.UserFields{}
+	}
+	input := model.UserInput{
+		UserName:   &fields.UserName,
+		FirstName:  &fields.FirstName,
+		LastName:   &fields.LastName,
+		Email:      &fields.Email,
+		Department: fields.Department,
+	}
+	if err := validate.Struct(input); err != nil {
+		return input, repo.Validation(validationErrorsToMap(err.(validator.ValidationErrors)))
+	}
+	if errs := requireUserFields(input); errs != nil {
+		return input, repo.Validation(errs)
+	}
+	return input, nil
+}
+
+func userFields(user *model.UserDTO) *pb.UserFields {
+	return &pb.UserFields{
+		UserName:   user.UserName,
+		FirstName:  user.FirstName,
+		LastName:   user.LastName,
+		Email:      user.Email,
+		Department: user.Department,
+		Version:    user.Version,
+	}
+}
+
+func page(paging model.Paging) *pb.Page {
+	result := &pb.Page{}
+	if paging.Page != nil {
+		result.Page = int32(*paging.Page)
+	}
+	if paging.PageCount != nil {
+		result.PageCount = int32(*paging.PageCount)
+	}
+	if paging.PageSize != nil {
+		result.PageSize = int32(*paging.PageSize)
+	}
+	return result
+}
+
+func (g grpcUsers[T]) get(ctx context.Context, id string) (*model.UserDTO, error) {
+	if errs := validateID("id", id, g.idTag); errs != nil {
+		return nil, repo.InvalidID(errs)
+	}
+	user, err := g.repo.WithContext(ctx).GetUser(id)
+	if err != nil {
+		return nil, err
+	}
+	return g.toDTO(user), nil
+}
+
+// list pages through the users as GetUsers does, 25 at a time from page 1
+// unless the request says otherwise.
+func (g grpcUsers[T]) list(ctx context.Context, request *pb.ListUsersRequest) (*model.UserPaging, error) {
+	page, limit := 1, 25
+	if request.GetPage() != 0 {
+		page = int(request.GetPage())
+	}
+	if request.GetLimit() != 0 {
+		limit = int(request.GetLimit())
+	}
+	return g.repo.WithContext(ctx).GetUsers(request.GetSearch(), page, limit)
+}
+
+// create inserts a user, whose ID the repository generates.
+func (g grpcUsers[T]) create(ctx context.Context, fields *pb.UserFields) (*model.UserDTO, error) {
+	input, err := userInput(fields)
+	if err != nil {
+		return nil, err
+	}
+	user, err := g.repo.WithContext(ctx).CreateUser(*g.toUser(input))
+	if err != nil {
+		return nil, err
+	}
+	return g.toDTO(user), nil
+}
+
+// update replaces a user as UpdateUser does. A version in fields makes the
+// write conditional, as If-Match does over HTTP.
+func (g grpcUsers[T]) update(ctx context.Context, id string, fields *pb.UserFields) (*model.UserDTO, error) {
+	input, err := userInput(fields)
+	if err != nil {
+		return nil, err
+	}
+	if errs := validateID("id", id, g.idTag); errs != nil {
+		return nil, repo.InvalidID(errs)
+	}
+	input.Id = &id
+	user := g.toUser(input)
+	g.base(user).Version = fields.GetVersion()
+	updated, err := g.repo.WithContext(ctx).UpdateUser(*user)
+	if err != nil {
+		return nil, err
+	}
+	return g.toDTO(updated), nil
+}
+
+func (g grpcUsers[T]) delete(ctx context.Context, id string) (*emptypb.Empty, error) {
+	if errs := validateID("id", id, g.idTag); errs != nil {
+		return nil, repo.InvalidID(errs)
+	}
+	if err := g.repo.WithContext(ctx).DeleteUser(id); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// bytesUsers serves the ID types whose IDs go over the wire as bytes.
+// decode returns false for bytes that are not an ID.
+type bytesUsers[T any] struct {
+	grpcUsers[T]
+	decode func([]byte) (string, bool)
+	encode func(string) ([]byte, error)
+}
+
+// id is the text of id, empty for no bytes so that it fails as a missing ID.
+func (b bytesUsers[T]) id(id []byte) (string, error) {
+	if len(id) == 0 {
+		return "", nil
+	}
+	text, ok := b.decode(id)
+	if !ok {
+		return "", repo.InvalidID(invalidWireID(b.idTag))
+	}
+	return text, nil
+}
+
+func (b bytesUsers[T]) user(user *model.UserDTO, err error) (*pb.BytesUser, error) {
+	if err != nil {
+		return nil, err
+	}
+	id, err := b.encode(user.ID)
+	if err != nil {
+		return nil, fmt.Errorf("encode id %s: %w", user.ID, err)
+	}
+	return &pb.BytesUser{Id: id, Fields: userFields(user)}, nil
+}
+
+func (b bytesUsers[T]) GetUser(ctx context.Context, request *pb.BytesUserID) (*pb.BytesUser, error) {
+	id, err := b.id(request.GetId())
+	if err != nil {
+		return nil, err
+	}
+	return b.user(b.get(ctx, id))
+}
+
+func (b bytesUsers[T]) ListUsers(ctx context.Context, request *pb.ListUsersRequest) (*pb.BytesUsers, error) {
+	paging, err := b.list(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	response := &pb.BytesUsers{Users: make([]*pb.BytesUser, 0, len(paging.Users)), Page: page(paging.Paging)}
+	for i := range paging.Users {
+		user, err := b.user(&paging.Users[i], nil)
+		if err != nil {
+			return nil, err
+		}
+		response.Users = append(response.Users, user)
+	}
+	return response, nil
+}
+
+func (b bytesUsers[T]) CreateUser(ctx context.Context, request *pb.UserFields) (*pb.BytesUser, error) {
+	return b.user(b.create(ctx, request))
+}
+
+func (b bytesUsers[T]) UpdateUser(ctx context.Context, request *pb.BytesUser) (*pb.BytesUser, error) {
+	id, err := b.id(request.GetId())
+	if err != nil {
+		return nil, err
+	}
+	return b.user(b.update(ctx, id, request.GetFields()))
+}
+
+func (b bytesUsers[T]) DeleteUser(ctx context.Context, request *pb.BytesUserID) (*emptypb.Empty, error) {
+	id, err := b.id(request.GetId())
+	if err != nil {
+		return nil, err
+	}
+	return b.delete(ctx, id)
+}
+
+// stringUsers serves the ID types whose IDs go over the wire as text.
+type stringUsers[T any] struct {
+	grpcUsers[T]
+}
+
+func (s stringUsers[T]) user(user *model.UserDTO, err error) (*pb.StringUser, error) {
+	if err != nil {
+		return nil, err
+	}
+	return &pb.StringUser{Id: user.ID, Fields: userFields(user)}, nil
+}
+
+func (s stringUsers[T]) GetUser(ctx context.Context, request *pb.StringUserID) (*pb.StringUser, error) {
+	return s.user(s.get(ctx, request.GetId()))
+}
+
+func (s stringUsers[T]) ListUsers(ctx context.Context, request *pb.ListUsersRequest) (*pb.StringUsers, error) {
+	paging, err := s.list(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	response := &pb.StringUsers{Users: make([]*pb.StringUser, 0, len(paging.Users)), Page: page(paging.Paging)}
+	for i := range paging.Users {
+		user, _ := s.user(&paging.Users[i], nil)
+		response.Users = append(response.Users, user)
+	}
+	return response, nil
+}
+
+func (s stringUsers[T]) CreateUser(ctx context.Context, request *pb.UserFields) (*pb.StringUser, error) {
+	return s.user(s.create(ctx, request))
+}
+
+func (s stringUsers[T]) UpdateUser(ctx context.Context, request *pb.StringUser) (*pb.StringUser, error) {
+	return s.user(s.update(ctx, request.GetId(), request.GetFields()))
+}
+
+func (s stringUsers[T]) DeleteUser(ctx context.Context, request *pb.StringUserID) (*emptypb.Empty, error) {
+	return s.delete(ctx, request.GetId())
+}
+
+// int64Users serves the ID types whose IDs go over the wire as numbers.
+type int64Users[T any] struct {
+	grpcUsers[T]
+}
+
+// int64ID is the text of id, empty for zero so that it fails as a missing ID.
+func int64ID(id int64) string {
+	if id == 0 {
+		return ""
+	}
+	return strconv.FormatInt(id, 10)
+}
+
+func (n int64Users[T]) user(user *model.UserDTO, err error) (*pb.Int64User, error) {
+	if err != nil {
+		return nil, err
+	}
+	id, err := strconv.ParseInt(user.ID, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("encode id %s: %w", user.ID, err)
+	}
+	return &pb.Int64User{Id: id, Fields: userFields(user)}, nil
+}
+
+func (n int64Users[T]) GetUser(ctx context.Context, request *pb.Int64UserID) (*pb.Int64User, error) {
+	return n.user(n.get(ctx, int64ID(request.GetId())))
+}
+
+func (n int64Users[T]) ListUsers(ctx context.Context, request *pb.ListUsersRequest) (*pb.Int64Users, error) {
+	paging, err := n.list(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	response := &pb.Int64Users{Users: make([]*pb.Int64User, 0, len(paging.Users)), Page: page(paging.Paging)}
+	for i := range paging.Users {
+		user, err := n.user(&paging.Users[i], nil)
+		if err != nil {
+			return nil, err
+		}
+		response.Users = append(response.Users, user)
+	}
+	return response, nil
+}
+
+func (n int64Users[T]) CreateUser(ctx context.Context, request *pb.UserFields) (*pb.Int64User, error) {
+	return n.user(n.create(ctx, request))
+}
+
+func (n int64Users[T]) UpdateUser(ctx context.Context, request *pb.Int64User) (*pb.Int64User, error) {
+	return n.user(n.update(ctx, int64ID(request.GetId()), request.GetFields()))
+}
+
+func (n int64Users[T]) DeleteUser(ctx context.Context, request *pb.Int64UserID) (*emptypb.Empty, error) {
+	return n.delete(ctx, int64ID(request.GetId()))
+}
+
+// Binary forms of the IDs sent as bytes.
+
+func ulidFromBytes(id []byte) (string, bool) {
+	var parsed ulid.ULID
+	if len(id) != len(parsed) {
+		return "", false
+	}
+	copy(parsed[:], id)
+	return parsed.String(), true
+}
+
+func ulidToBytes(id string) ([]byte, error) {
+	parsed, err := ulid.Parse(id)
+	return parsed[:], err
+}
+
+func uuidFromBytes(id []byte) (string, bool) {
+	parsed, err := uuid.FromBytes(id)
+	return parsed.String(), err == nil
+}
+
+func uuidToBytes(id string) ([]byte, error) {
+	parsed, err := uuid.Parse(id)
+	return parsed[:], err
+}
+
+func ksuidFromBytes(id []byte) (string, bool) {
+	parsed, err := ksuid.FromBytes(id)
+	return parsed.String(), err == nil
+}
+
+func ksuidToBytes(id string) ([]byte, error) {
+	parsed, err := ksuid.Parse(id)
+	return parsed.Bytes(), err
+}
@@ -0,0 +1,147 @@
+package controller
+
+import (
+	"context"
+	"net/http"
+	"net/http/pprof"
+	"runtime"
+	"runtime/debug"
+	"sort"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	appMiddleware "github.com/theCompanyDream/id-trials/apps/backend/middleware"
+	model "github.com/theCompanyDream/id-trials/apps/backend/models"
+	repo "github.com/theCompanyDream/id-trials/apps/backend/repository"
+)
+
+// Version is the release of the binary, set at build time with
+// -ldflags "-X github.com/theCompanyDream/id-trials/apps/backend/controller.Version=v1.2.3".
+var Version = "dev"
+
+// readyTimeout bounds the database checks of one readiness probe.
+const readyTimeout = 2 * time.Second
+
+// Healthz godoc
+// @Summary Liveness probe
+// @Description Returns 200 while the process is able to serve requests, without checking its dependencies
+// @Tags Health
+// @Produce json
+// @Success 200 {object} models.HealthStatus
+// @Router /healthz [get]
+func Healthz(c echo.Context) error {
+	return c.JSON(http.StatusOK, model.HealthStatus{Status: "ok"})
+}
+
+// Ready reports whether the server accepts new traffic.
+func (s *Server) Ready() bool {
+	return !s.draining.Load()
+}
+
+// Readyz godoc
+// @Summary Readiness probe
+// @Description Returns 200 when the database answers, every migration is applied and metrics are being written, and 503 otherwise or once the server is shutting down
+// @Tags Health
+// @Produce json
+// @Success 200 {object} models.HealthStatus
+// @Failure 503 {object} models.HealthStatus
+// @Router /readyz [get]
+func (s *Server) Readyz(c echo.Context) error {
+	if !s.Ready() {
+		return c.JSON(http.StatusServiceUnavailable, model.HealthStatus{Status: "draining"})
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request().Context(), readyTimeout)
+	defer cancel()
+
+	checks := map[string]error{}
+	sqlDB, err := s.db.DB()
+	if err == nil {
+		err = sqlDB.PingContext(ctx)
+	}
+	checks["database"] = err
+	if err == nil {
+		checks["migrations"] = repo.CheckSchema(s.db.WithContext(ctx))
+	}
+	checks["metrics"] = s.metrics.Healthy()
+
+	status := model.HealthStatus{Status: "ready", Checks: map[string]string{}}
+	code := http.StatusOK
+	for name, err := range checks {
+		if err != nil {
+			status.Checks[name] = err.Error()
+			status.Status = "unavailable"
+			code = http.StatusServiceUnavailable
+		} else {
+			status.Checks[name] = "ok"
+		}
+	}
+	return c.JSON(code, status)
+}
+
+// DebugInfo godoc
+// @Summary Server diagnostics
+// @Description Returns the build version, uptime, the ID types with registered routes, connection pool stats and the configuration with secrets redacted
+// @Tags Health
+// @Produce json
+// @Success 200 {object} models.DebugInfo
+// @Router /debug/info [get]
+func (s *Server) DebugInfo(c echo.Context) error {
+	info := model.DebugInfo{
+		Version:       Version,
+		GoVersion:     runtime.Version(),
+		StartedAt:     s.started,
+		UptimeSeconds: time.Since(s.started).Seconds(),
+		IDTypes:       s.idTypes(),
+		Config:        s.cfg.Summary(),
+	}
+	if build, ok := debug.ReadBuildInfo(); ok {
+		for _, setting := range build.Settings {
+			if setting.Key == "vcs.revision" {
+				info.Revision = setting.Value
+			}
+		}
+	}
+	if sqlDB, err := s.db.DB(); err == nil {
+		stats := sqlDB.Stats()
+		info.Pool = model.PoolInfo{
+			MaxOpen:           stats.MaxOpenConnections,
+			Open:              stats.OpenConnections,
+			InUse:             stats.InUse,
+			Idle:              stats.Idle,
+			WaitCount:         stats.WaitCount,
+			WaitDuration:      float64(stats.WaitDuration) / float64(time.Millisecond),
+			MaxIdleClosed:     stats.MaxIdleClosed,
+			MaxIdleTimeClosed: stats.MaxIdleTimeClosed,
+			MaxLifetimeClosed: stats.MaxLifetimeClosed,
+		}
+	}
+	return c.JSON(http.StatusOK, info)
+}
+
+// idTypes counts the registered routes of each ID type.
+func (s *Server) idTypes() []model.IDTypeRoutes {
+	counts := map[string]int{}
+	for _, route := range s.Routes() {
+		if idType := appMiddleware.ExtractIDType(route.Path); idType != "Unknown" {
+			counts[idType]++
+		}
+	}
+
+	idTypes := make([]model.IDTypeRoutes, 0, len(counts))
+	for idType, routes := range counts {
+		idTypes = append(idTypes, model.IDTypeRoutes{IDType: idType, Routes: routes})
+	}
+	sort.Slice(idTypes, func(i, j int) bool { return idTypes[i].IDType < idTypes[j].IDType })
+	return idTypes
+}
+
+// registerPprof serves the net/http/pprof profiles under /debug/pprof.
+func registerPprof(router *echo.Group) {
+	router.GET("/debug/pprof/cmdline", echo.WrapHandler(http.HandlerFunc(pprof.Cmdline)))
+	router.GET("/debug/pprof/profile", echo.WrapHandler(http.HandlerFunc(pprof.Profile)))
+	router.GET("/debug/pprof/symbol", echo.WrapHandler(http.HandlerFunc(pprof.Symbol)))
+	router.POST("/debug/pprof/symbol", echo.WrapHandler(http.HandlerFunc(pprof.Symbol)))
+	router.GET("/debug/pprof/trace", echo.WrapHandler(http.HandlerFunc(pprof.Trace)))
+	router.GET("/debug/pprof/*", echo.WrapHandler(http.HandlerFunc(pprof.Index)))
+}
@@ -0,0 +1,194 @@
+package controller
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+	model "github.com/theCompanyDream/id-trials/apps/backend/models"
+	repo "github.com/theCompanyDream/id-trials/apps/backend/repository"
+	"gorm.io/gorm"
+)
+
+type KsuidOrdersController struct {
+	Repo repo.IOrderRepository[model.OrderKSUID]
+}
+
+func NewGormKsuidOrderController(db *gorm.DB) IOrderController {
+	repository := repo.NewGormKsuidOrderRepository(db)
+
+	return &KsuidOrdersController{
+		Repo: repository,
+	}
+}
+
+// GetOrder godoc
+// @Summary Get a single order
+// @Description Get an order by its ID
+// @Tags order
+// @Accept json
+// @Produce json
+// @Param id path string true "Order ID"
+// @Success 200 {object} models.OrderKSUID "Order Found"
+// @Failure 400 {object} models.Problem "Bad Request"
+// @Failure 404 {object} models.Problem "Not Found"
+// @Router /api/v1/ids/ksuid/orders/{id} [get]
+func (uoc *KsuidOrdersController) GetOrder(c echo.Context) error {
+	id := c.Param("id")
+	if errs := validateID("id", id, "ksuid"); errs != nil {
+		return repo.InvalidID(errs)
+	}
+	order, err := uoc.Repo.WithContext(c.Request().Context()).GetOrder(id)
+	if err != nil {
+		return err
+	}
+	return c.JSON(http.StatusOK, order)
+}
+
+// GetOrders godoc
+// @Summary Get a user's orders
+// @Description Get a page of the orders placed by a user, joined to the user
+// @Tags order
+// @Accept json
+// @Produce json
+// @Param id path string true "User ID"
+// @Param limit query int false "Limit"
+// @Param page query int false "Page Number"
+// @Success 200 {object} models.OrderPaging "Orders Found"
+// @Failure 400 {object} models.Problem "Bad Request"
+// @Failure 404 {object} models.Problem "Not Found"
+// @Router /api/v1/ids/ksuid/users/{id}/orders [get]
+func (uoc *KsuidOrdersController) GetOrders(c echo.Context) error {
+	var page, limit int
+	userId := c.Param("id")
+	if errs := validateID("id", userId, "ksuid"); errs != nil {
+		return repo.InvalidID(errs)
+	}
+	if queryLimit := c.QueryParam("limit"); queryLimit != "" {
+		i, _ := strconv.Atoi(queryLimit)
+		limit = i
+	} else {
+		limit = 25
+	}
+	if queryPage := c.QueryParam("page"); queryPage != "" {
+		i, _ := strconv.Atoi(queryPage)
+		page = i
+	} else {
+		page = 1
+	}
+	orders, err := uoc.Repo.WithContext(c.Request().Context()).GetOrdersByUser(userId, page, limit)
+	if err != nil {
+		return err
+	}
+	return c.JSON(http.StatusOK, orders)
+}
+
+// CreateOrder godoc
+// @Summary Create an order
+// @Description Create a new order for a user
+// @Tags order
+// @Accept json
+// @Produce json
+// @Param id path string true "User ID"
+// @Param order body models.OrderInput true "Order object"
+// @Success 201 {object} models.OrderKSUID "Order Created"
+// @Failure 400 {object} models.Problem "Bad Request"
+// @Failure 409 {object} models.Problem "Conflict"
+// @Failure 422 {object} models.Problem "Unprocessable Entity"
+// @Router /api/v1/ids/ksuid/users/{id}/orders [post]
+func (uoc *KsuidOrdersController) CreateOrder(c echo.Context) error {
+	request := model.OrderInput{}
+	err := c.Bind(&request)
+	if err != nil {
+		return err
+	}
+	err = validate.Struct(request)
+	if err != nil {
+		validationErrors := err.(validator.ValidationErrors)
+		return repo.Validation(validationErrorsToMap(validationErrors))
+	}
+	if errs := requireOrderFields(request); errs != nil {
+		return repo.Validation(errs)
+	}
+	if request.Id != nil {
+		if errs := validateID("id", *request.Id, "ksuid"); errs != nil {
+			return repo.Validation(errs)
+		}
+	}
+	userId := c.Param("id")
+	if errs := validateID("id", userId, "ksuid"); errs != nil {
+		return repo.InvalidID(errs)
+	}
+	dto := model.InputToOrderKSUID(request, userId)
+	order, err := uoc.Repo.WithContext(c.Request().Context()).CreateOrder(*dto)
+	if err != nil {
+		return err
+	}
+	return c.JSON(http.StatusCreated, order)
+}
+
+// UpdateOrder godoc
+// @Summary Update an order
+// @Description Update an order's information by its ID
+// @Tags order
+// @Accept json
+// @Produce json
+// @Param id path string true "Order ID"
+// @Param order body models.OrderInput true "Order object"
+// @Success 200 {object} models.OrderKSUID "Order Updated"
+// @Failure 400 {object} models.Problem "Bad Request"
+// @Failure 404 {object} models.Problem "Not Found"
+// @Failure 422 {object} models.Problem "Unprocessable Entity"
+// @Router /api/v1/ids/ksuid/orders/{id} [put]
+func (uoc *KsuidOrdersController) UpdateOrder(c echo.Context) error {
+	request := model.OrderInput{}
+	err := c.Bind(&request)
+	if err != nil {
+		return err
+	}
+	err = validate.Struct(request)
+	if err != nil {
+		validationErrors := err.(validator.ValidationErrors)
+		return repo.Validation(validationErrorsToMap(validationErrors))
+	}
+	if request.Id != nil {
+		if errs := validateID("id", *request.Id, "ksuid"); errs != nil {
+			return repo.Validation(errs)
+		}
+	}
+	id := c.Param("id")
+	if errs := validateID("id", id, "ksuid"); errs != nil {
+		return repo.InvalidID(errs)
+	}
+	request.Id = &id
+	dto := model.InputToOrderKSUID(request, "")
+	order, err := uoc.Repo.WithContext(c.Request().Context()).UpdateOrder(*dto)
+	if err != nil {
+		return err
+	}
+	return c.JSON(http.StatusOK, order)
+}
+
+// DeleteOrder godoc
+// @Summary Delete an order
+// @Description Delete an order by its ID
+// @Tags order
+// @Accept json
+// @Produce json
+// @Param id path string true "Order ID"
+// @Success 200 "Order Deleted"
+// @Failure 400 {object} models.Problem "Bad Request"
+// @Failure 404 {object} models.Problem "Not Found"
+// @Router /api/v1/ids/ksuid/orders/{id} [delete]
+func (uoc *KsuidOrdersController) DeleteOrder(c echo.Context) error {
+	id := c.Param("id")
+	if errs := validateID("id", id, "ksuid"); errs != nil {
+		return repo.InvalidID(errs)
+	}
+	err := uoc.Repo.WithContext(c.Request().Context()).DeleteOrder(id)
+	if err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,48 @@
+package controller
+
+import (
+	"encoding/json"
+	"io"
+	"mime"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	model "github.com/theCompanyDream/id-trials/apps/backend/models"
+	repo "github.com/theCompanyDream/id-trials/apps/backend/repository"
+	"github.com/theCompanyDream/id-trials/apps/backend/utils"
+)
+
+// MIMEApplicationMergePatchJSON is the media type of RFC 7396 merge patches.
+const MIMEApplicationMergePatchJSON = "application/merge-patch+json"
+
+// mergePatchUser applies the request body, a JSON Merge Patch, to the current
+// representation of a user and returns the full result. The ID cannot be patched.
+func mergePatchUser(c echo.Context, current *model.UserDTO) (model.UserInput, error) {
+	var request model.UserInput
+
+	mediaType, _, _ := mime.ParseMediaType(c.Request().Header.Get(echo.HeaderContentType))
+	if mediaType != MIMEApplicationMergePatchJSON && mediaType != echo.MIMEApplicationJSON {
+		return request, echo.ErrUnsupportedMediaType
+	}
+
+	patch, err := io.ReadAll(c.Request().Body)
+	if err != nil {
+		return request, err
+	}
+	document, err := json.Marshal(current)
+	if err != nil {
+		return request, err
+	}
+	merged, err := utils.MergePatch(document, patch)
+	if err != nil {
+		return request, echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+	if err := json.Unmarshal(merged, &request); err != nil {
+		return request, echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	if request.Id == nil || *request.Id != current.ID {
+		return request, repo.Validation(map[string]string{"id": "Field 'id' cannot be changed"})
+	}
+	return request, nil
+}
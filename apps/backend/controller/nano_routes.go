@@ -1,7 +1,6 @@
 package controller
 
 import (
-	"errors"
 	"net/http"
 	"strconv"
 
@@ -30,21 +29,26 @@ func NewGormNanoController(db *gorm.DB) IUserController {
 // @Tags user
 // @Accept json
 // @Produce json
-// @Param id path string false "User ID"
-// @Param user_name path string false "Username"
-// @Success 302 {object} models.UserInput "User Found"
-// @Failure 400 {object} object "Bad Request"
-// @Router /nano/{id} [get]
+// @Param id path string true "User ID"
+// @Param If-None-Match header string false "ETag of a cached copy"
+// @Success 200 {object} models.UserNanoID "User Found"
+// @Success 304 "Not Modified"
+// @Failure 400 {object} models.Problem "Bad Request"
+// @Failure 404 {object} models.Problem "Not Found"
+// @Router /api/v1/ids/nanoid/users/{id} [get]
 func (uuc *NanoUsersController) GetUser(c echo.Context) error {
 	// Extract the user ID from the URL and query the database
 	id := c.Param("id")
-	if id == "" {
-		return c.JSON(http.StatusNotFound, errors.New("id not applicable there"))
+	if errs := validateID("id", id, "nanoid"); errs != nil {
+		return repo.InvalidID(errs)
 	}
-	user, err := uuc.Repo.GetUser(id)
+	user, err := uuc.Repo.WithContext(c.Request().Context()).GetUser(id)
 	if err != nil {
 		return err
 	}
+	if done, err := notModified(c, user.Version); done {
+		return err
+	}
 	return c.JSON(http.StatusOK, user)
 }
 
@@ -57,9 +61,9 @@ func (uuc *NanoUsersController) GetUser(c echo.Context) error {
 // @Param search query string false "Search Term"
 // @Param limit query int false "Limit"
 // @Param page query int false "Page Number"
-// @Success 302 {object} []models.UserPaging "Users Found"
-// @Failure 400 {object} object "Bad Request"
-// @Router /nanos [get]
+// @Success 200 {object} models.UserPaging "Users Found"
+// @Failure 400 {object} models.Problem "Bad Request"
+// @Router /api/v1/ids/nanoid/users [get]
 func (uuc *NanoUsersController) GetUsers(c echo.Context) error {
 	// Extract the user ID from the URL and query the database
 	var page, limit int
@@ -76,7 +80,7 @@ func (uuc *NanoUsersController) GetUsers(c echo.Context) error {
 	} else {
 		page = 1
 	}
-	users, error := uuc.Repo.GetUsers(search, page, limit)
+	users, error := uuc.Repo.WithContext(c.Request().Context()).GetUsers(search, page, limit)
 	if error != nil {
 		return error
 	}
@@ -91,9 +95,11 @@ func (uuc *NanoUsersController) GetUsers(c echo.Context) error {
 // @Accept json
 // @Produce json
 // @Param user body models.UserInput true "User object"
-// @Success 201 {object} models.UserInput "User Created"
-// @Failure 400 {object} object "Bad Request"
-// @Router /nano [post]
+// @Success 201 {object} models.UserNanoID "User Created"
+// @Failure 400 {object} models.Problem "Bad Request"
+// @Failure 409 {object} models.Problem "Conflict"
+// @Failure 422 {object} models.Problem "Unprocessable Entity"
+// @Router /api/v1/ids/nanoid/users [post]
 func (uuc *NanoUsersController) CreateUser(c echo.Context) error {
 	// Parse user details from the request body and insert into the database
 	request := model.UserInput{}
@@ -104,10 +110,15 @@ func (uuc *NanoUsersController) CreateUser(c echo.Context) error {
 	err = validate.Struct(request)
 	if err != nil {
 		validationErrors := err.(validator.ValidationErrors)
-		return c.JSON(http.StatusUnprocessableEntity, validationErrorsToMap(validationErrors))
+		return repo.Validation(validationErrorsToMap(validationErrors))
+	}
+	if request.Id != nil {
+		if errs := validateID("id", *request.Id, "nanoid"); errs != nil {
+			return repo.Validation(errs)
+		}
 	}
 	dto := model.InputToNanoId(request)
-	user, error := uuc.Repo.CreateUser(*dto)
+	user, error := uuc.Repo.WithContext(c.Request().Context()).CreateUser(*dto)
 	if error != nil {
 		return error
 	}
@@ -115,16 +126,20 @@ func (uuc *NanoUsersController) CreateUser(c echo.Context) error {
 }
 
 // UpdateUser godoc
-// @Summary Update a user
-// @Description Update a user's information by their ID
+// @Summary Replace a user
+// @Description Replace a user's information by their ID. Every field is written, so omitted optional fields are cleared
 // @Tags user
 // @Accept json
 // @Produce json
 // @Param id path string true "User ID"
 // @Param user body models.UserInput true "User object"
-// @Success 200 {object} models.UserInput "User Updated"
-// @Failure 400 {object} object "Bad Request"
-// @Router /nano/{id} [put]
+// @Param If-Match header string false "ETag the write is conditional on"
+// @Success 200 {object} models.UserNanoID "User Updated"
+// @Failure 400 {object} models.Problem "Bad Request"
+// @Failure 404 {object} models.Problem "Not Found"
+// @Failure 422 {object} models.Problem "Unprocessable Entity"
+// @Failure 412 {object} models.Problem "Precondition Failed"
+// @Router /api/v1/ids/nanoid/users/{id} [put]
 func (uuc *NanoUsersController) UpdateUser(c echo.Context) error {
 	// Parse user details from the request body and insert into the database
 	// request := checkConstraints(c)
@@ -136,16 +151,92 @@ func (uuc *NanoUsersController) UpdateUser(c echo.Context) error {
 	err = validate.Struct(request)
 	if err != nil {
 		validationErrors := err.(validator.ValidationErrors)
-		return c.JSON(http.StatusUnprocessableEntity, validationErrorsToMap(validationErrors))
+		return repo.Validation(validationErrorsToMap(validationErrors))
 	}
-	if id := c.Param("id"); id != "" {
-		request.Id = &id
+	if request.Id != nil {
+		if errs := validateID("id", *request.Id, "nanoid"); errs != nil {
+			return repo.Validation(errs)
+		}
+	}
+	if errs := requireUserFields(request); errs != nil {
+		return repo.Validation(errs)
+	}
+	id := c.Param("id")
+	if errs := validateID("id", id, "nanoid"); errs != nil {
+		return repo.InvalidID(errs)
 	}
+	request.Id = &id
 	dto := model.InputToNanoId(request)
-	user, error := uuc.Repo.UpdateUser(*dto)
+	repository := uuc.Repo.WithContext(c.Request().Context())
+	// A conditional replace only lands on the version the client has seen
+	if hasIfMatch(c) {
+		current, err := repository.GetUser(id)
+		if err != nil {
+			return err
+		}
+		if err := checkIfMatch(c, id, current.Version); err != nil {
+			return err
+		}
+		dto.Version = current.Version
+	}
+	user, error := repository.UpdateUser(*dto)
 	if error != nil {
 		return error
 	}
+	setETag(c, user.Version)
+	return c.JSON(http.StatusOK, user)
+}
+
+// PatchUser godoc
+// @Summary Patch a user
+// @Description Apply a JSON Merge Patch (RFC 7396) to a user. Omitted fields are left alone and fields set to null are cleared
+// @Tags user
+// @Accept json
+// @Accept application/merge-patch+json
+// @Produce json
+// @Param id path string true "User ID"
+// @Param user body models.UserInput true "Merge patch"
+// @Param If-Match header string false "ETag the write is conditional on"
+// @Success 200 {object} models.UserNanoID "User Updated"
+// @Failure 400 {object} models.Problem "Bad Request"
+// @Failure 404 {object} models.Problem "Not Found"
+// @Failure 415 {object} models.Problem "Unsupported Media Type"
+// @Failure 422 {object} models.Problem "Unprocessable Entity"
+// @Failure 412 {object} models.Problem "Precondition Failed"
+// @Router /api/v1/ids/nanoid/users/{id} [patch]
+func (uuc *NanoUsersController) PatchUser(c echo.Context) error {
+	id := c.Param("id")
+	if errs := validateID("id", id, "nanoid"); errs != nil {
+		return repo.InvalidID(errs)
+	}
+	repository := uuc.Repo.WithContext(c.Request().Context())
+	current, err := repository.GetUser(id)
+	if err != nil {
+		return err
+	}
+	if err := checkIfMatch(c, id, current.Version); err != nil {
+		return err
+	}
+	request, err := mergePatchUser(c, current.NanoIdToDTO())
+	if err != nil {
+		return err
+	}
+	err = validate.Struct(request)
+	if err != nil {
+		validationErrors := err.(validator.ValidationErrors)
+		return repo.Validation(validationErrorsToMap(validationErrors))
+	}
+	if errs := requireUserFields(request); errs != nil {
+		return repo.Validation(errs)
+	}
+	dto := model.InputToNanoId(request)
+	// The merge was computed from current, so the write is always conditional on it
+	dto.Version = current.Version
+	user, err := repository.UpdateUser(*dto)
+	if err != nil {
+		return err
+	}
+	setETag(c, user.Version)
 	return c.JSON(http.StatusOK, user)
 }
 
@@ -156,18 +247,93 @@ func (uuc *NanoUsersController) UpdateUser(c echo.Context) error {
 // @Accept json
 // @Produce json
 // @Param id path string true "User ID"
-// @Success 200 {string} string "User Deleted"
-// @Failure 400 {object} object "Bad Request"
-// @Router /nano/{id} [delete]
+// @Success 200 "User Deleted"
+// @Failure 400 {object} models.Problem "Bad Request"
+// @Failure 404 {object} models.Problem "Not Found"
+// @Router /api/v1/ids/nanoid/users/{id} [delete]
 func (uuc *NanoUsersController) DeleteUser(c echo.Context) error {
 	// Parse user details from the request body and insert into the database
 	id := c.Param("id")
-	if id == "" {
-		return errors.New("id must not be null")
+	if errs := validateID("id", id, "nanoid"); errs != nil {
+		return repo.InvalidID(errs)
 	}
-	err := uuc.Repo.DeleteUser(id)
+	err := uuc.Repo.WithContext(c.Request().Context()).DeleteUser(id)
 	if err != nil {
 		return err
 	}
 	return nil
 }
+
+func (uuc *NanoUsersController) batch() userBatch[model.UserNanoID] {
+	return userBatch[model.UserNanoID]{
+		repo:   uuc.Repo,
+		idTag:  "nanoid",
+		toUser: model.InputToNanoId,
+		toDTO:  (*model.UserNanoID).NanoIdToDTO,
+	}
+}
+
+// CreateUsers godoc
+// @Summary Create users in bulk
+// @Description Create up to BATCH_MAX_ITEMS users with multi-row inserts. Each item reports its own result unless atomic is set, in which case every user is inserted or none is
+// @Tags user
+// @Accept json
+// @Produce json
+// @Param users body models.BatchCreateRequest true "Users to create"
+// @Success 201 {object} models.BatchResponse "Users Created"
+// @Success 207 {object} models.BatchResponse "Some Users Failed"
+// @Failure 400 {object} models.Problem "Bad Request"
+// @Failure 409 {object} models.Problem "Conflict"
+// @Failure 422 {object} models.Problem "Unprocessable Entity"
+// @Router /api/v1/ids/nanoid/users/batch [post]
+func (uuc *NanoUsersController) CreateUsers(c echo.Context) error {
+	return uuc.batch().create(c)
+}
+
+// GetUsersByID godoc
+// @Summary Get users in bulk
+// @Description Get up to BATCH_MAX_ITEMS users by ID in one query. Missing IDs are reported per item unless atomic is set
+// @Tags user
+// @Accept json
+// @Produce json
+// @Param ids body models.BatchIDsRequest true "IDs to read"
+// @Success 200 {object} models.BatchResponse "Users Found"
+// @Success 207 {object} models.BatchResponse "Some Users Failed"
+// @Failure 400 {object} models.Problem "Bad Request"
+// @Failure 404 {object} models.Problem "Not Found"
+// @Failure 422 {object} models.Problem "Unprocessable Entity"
+// @Router /api/v1/ids/nanoid/users/batch/get [post]
+func (uuc *NanoUsersController) GetUsersByID(c echo.Context) error {
+	return uuc.batch().get(c)
+}
+
+// DeleteUsers godoc
+// @Summary Delete users in bulk
+// @Description Delete up to BATCH_MAX_ITEMS users by ID in one transaction. Missing IDs are reported per item unless atomic is set, in which case nothing is deleted
+// @Tags user
+// @Accept json
+// @Produce json
+// @Param ids body models.BatchIDsRequest true "IDs to delete"
+// @Success 200 {object} models.BatchResponse "Users Deleted"
+// @Success 207 {object} models.BatchResponse "Some Users Failed"
+// @Failure 400 {object} models.Problem "Bad Request"
+// @Failure 404 {object} models.Problem "Not Found"
+// @Failure 422 {object} models.Problem "Unprocessable Entity"
+// @Router /api/v1/ids/nanoid/users/batch [delete]
+func (uuc *NanoUsersController) DeleteUsers(c echo.Context) error {
+	return uuc.batch().delete(c)
+}
+
+// ExportUsers godoc
+// @Summary Export users
+// @Description Stream every user, optionally filtered by search, as NDJSON or CSV depending on the Accept header. Rows are read with a keyset scan and flushed as they are written
+// @Tags user
+// @Produce application/x-ndjson
+// @Produce text/csv
+// @Param search query string false "Search Term"
+// @Success 200 {string} string "Users Exported"
+// @Failure 406 {object} models.Problem "Not Acceptable"
+// @Router /api/v1/ids/nanoid/users/export [get]
+func (uuc *NanoUsersController) ExportUsers(c echo.Context) error {
+	return streamUsers(c, uuc.Repo.WithContext(c.Request().Context()).ExportUsers)
+}
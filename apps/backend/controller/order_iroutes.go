@@ -0,0 +1,43 @@
+package controller
+
+import (
+	"github.com/labstack/echo/v4"
+	repo "github.com/theCompanyDream/id-trials/apps/backend/repository"
+)
+
+type IOrderController interface {
+	GetOrder(c echo.Context) error
+	GetOrders(c echo.Context) error
+	CreateOrder(c echo.Context) error
+	UpdateOrder(c echo.Context) error
+	DeleteOrder(c echo.Context) error
+}
+
+// partitionedOrders refuses every order route of an ID type whose users are
+// served from its partitioned table. The orders tables reference the
+// unpartitioned users tables, so those users cannot have orders.
+type partitionedOrders struct {
+	idType string
+}
+
+// ordersFor returns orders, or partitionedOrders when partitioned storage
+// serves the users of idType.
+func ordersFor(idType string, orders IOrderController) IOrderController {
+	if repo.StorageMode(idType) == "partitioned" {
+		return partitionedOrders{idType: idType}
+	}
+	return orders
+}
+
+func (p partitionedOrders) err() error {
+	return &repo.DomainError{
+		Kind:    repo.KindConflict,
+		Message: p.idType + " orders are unavailable with storage.partitioned set: orders reference the unpartitioned users table",
+	}
+}
+
+func (p partitionedOrders) GetOrder(echo.Context) error    { return p.err() }
+func (p partitionedOrders) GetOrders(echo.Context) error   { return p.err() }
+func (p partitionedOrders) CreateOrder(echo.Context) error { return p.err() }
+func (p partitionedOrders) UpdateOrder(echo.Context) error { return p.err() }
+func (p partitionedOrders) DeleteOrder(echo.Context) error { return p.err() }
@@ -0,0 +1,156 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"sync/atomic"
+	"syscall"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/theCompanyDream/id-trials/apps/backend/config"
+	appMiddleware "github.com/theCompanyDream/id-trials/apps/backend/middleware"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	"gorm.io/gorm"
+)
+
+// Server is the long-running API server: the Echo instance, the gRPC server
+// on its own port, plus what has to happen when they stop.
+type Server struct {
+	*echo.Echo
+	GRPC *grpc.Server
+	// GRPCListener is served instead of listening on server.grpc_port when
+	// set, as Echo's Listener is
+	GRPCListener net.Listener
+
+	db      *gorm.DB
+	cfg     *config.Config
+	metrics *appMiddleware.MetricsMiddleware
+
+	// draining is set once shutdown begins, failing readiness
+	draining atomic.Bool
+	// grpcHealth is the gRPC health service, not serving once draining
+	grpcHealth *health.Server
+
+	started time.Time
+}
+
+// RunServer serves until SIGINT or SIGTERM, drains the server and closes the
+// database pool.
+func RunServer(db *gorm.DB, cfg *config.Config) error {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	err := NewEchoServer(db, cfg).Run(ctx)
+
+	sqlDB, dbErr := db.DB()
+	if dbErr == nil {
+		dbErr = sqlDB.Close()
+	}
+	if dbErr != nil {
+		dbErr = fmt.Errorf("close database: %w", dbErr)
+	}
+	return errors.Join(err, dbErr)
+}
+
+// Run serves HTTP, and gRPC unless its port is 0 and no GRPCListener is set,
+// until ctx is done. It then fails readiness for DrainDelay, gives in-flight
+// requests, calls and pending metric writes up to DrainTimeout, and stops the
+// pool sampler.
+func (s *Server) Run(ctx context.Context) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	grpcListener := s.GRPCListener
+	if grpcListener == nil && s.cfg.Server.GRPCPort != 0 {
+		listener, err := net.Listen("tcp", s.cfg.Server.GRPCAddress())
+		if err != nil {
+			return fmt.Errorf("listen for gRPC: %w", err)
+		}
+		grpcListener = listener
+	}
+
+	samplerDone := make(chan struct{})
+	go func() {
+		defer close(samplerDone)
+		if interval := s.cfg.Metrics.PoolSampleInterval; interval > 0 {
+			appMiddleware.NewPoolSampler(s.db, interval).Run(ctx)
+		}
+	}()
+
+	served := make(chan error, 1)
+	go func() {
+		s.Logger.Info("Server is running...")
+		served <- s.Start(s.cfg.Server.Address())
+	}()
+
+	// Serve returns only once stopped or when the listener fails
+	grpcServed := make(chan error, 1)
+	if grpcListener != nil {
+		go func() {
+			s.Logger.Infof("gRPC server is running on %s", grpcListener.Addr())
+			grpcServed <- s.GRPC.Serve(grpcListener)
+		}()
+	}
+
+	select {
+	case err := <-served:
+		// The listener failed, so there is nothing to drain
+		cancel()
+		s.GRPC.Stop()
+		<-samplerDone
+		if errors.Is(err, http.ErrServerClosed) {
+			return nil
+		}
+		return err
+	case err := <-grpcServed:
+		cancel()
+		err = errors.Join(fmt.Errorf("serve gRPC: %w", err), s.Close())
+		<-served
+		<-samplerDone
+		return err
+	case <-ctx.Done():
+	}
+
+	s.draining.Store(true)
+	s.grpcHealth.Shutdown()
+	s.Logger.Info("Shutting down, draining requests...")
+	time.Sleep(s.cfg.Server.DrainDelay)
+
+	drainCtx, cancelDrain := context.WithTimeout(context.Background(), s.cfg.Server.DrainTimeout)
+	defer cancelDrain()
+
+	grpcStopped := make(chan error, 1)
+	go func() {
+		grpcStopped <- s.stopGRPC(drainCtx)
+	}()
+
+	var errs []error
+	if err := s.Shutdown(drainCtx); err != nil {
+		errs = append(errs, fmt.Errorf("drain requests: %w", err))
+	}
+	if err := <-served; err != nil && !errors.Is(err, http.ErrServerClosed) {
+		errs = append(errs, err)
+	}
+	if err := <-grpcStopped; err != nil {
+		errs = append(errs, fmt.Errorf("drain gRPC calls: %w", err))
+	}
+	if grpcListener != nil {
+		if err := <-grpcServed; err != nil {
+			errs = append(errs, fmt.Errorf("serve gRPC: %w", err))
+		}
+	}
+	<-samplerDone
+	if err := s.metrics.Flush(drainCtx); err != nil {
+		errs = append(errs, fmt.Errorf("flush metrics: %w", err))
+	}
+
+	s.Logger.Info("Server stopped")
+	return errors.Join(errs...)
+}
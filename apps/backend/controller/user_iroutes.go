@@ -7,5 +7,10 @@ type IUserController interface {
 	GetUsers(c echo.Context) error
 	CreateUser(c echo.Context) error
 	UpdateUser(c echo.Context) error
+	PatchUser(c echo.Context) error
 	DeleteUser(c echo.Context) error
+	CreateUsers(c echo.Context) error
+	GetUsersByID(c echo.Context) error
+	DeleteUsers(c echo.Context) error
+	ExportUsers(c echo.Context) error
 }
@@ -4,9 +4,12 @@ import (
 	"fmt"
 
 	"github.com/go-playground/validator/v10"
+
+	model "github.com/theCompanyDream/id-trials/apps/backend/models"
+	"github.com/theCompanyDream/id-trials/apps/backend/utils"
 )
 
-var validate = validator.New(validator.WithRequiredStructEnabled())
+var validate = utils.NewValidator()
 
 // Converts validation errors to a map
 func validationErrorsToMap(valErrs validator.ValidationErrors) map[string]string {
@@ -17,3 +20,47 @@ func validationErrorsToMap(valErrs validator.ValidationErrors) map[string]string
 	}
 	return errors
 }
+
+// validateID checks id against the validator tag of its format and returns the
+// failures keyed by field, or nil when id is valid.
+func validateID(field, id, tag string) map[string]string {
+	if err := validate.Var(id, "required,"+tag); err != nil {
+		failed := tag
+		if valErrs, ok := err.(validator.ValidationErrors); ok && len(valErrs) > 0 {
+			failed = valErrs[0].Tag()
+		}
+		return map[string]string{
+			field: fmt.Sprintf("Field validation for '%s' failed on the '%s' tag", field, failed),
+		}
+	}
+	return nil
+}
+
+// requireUserFields reports the fields a full replacement of a user is missing.
+func requireUserFields(request model.UserInput) map[string]string {
+	errors := make(map[string]string)
+	for field, value := range map[string]*string{
+		"UserName":  request.UserName,
+		"FirstName": request.FirstName,
+		"LastName":  request.LastName,
+		"Email":     request.Email,
+	} {
+		if value == nil || *value == "" {
+			errors[field] = fmt.Sprintf("Field validation for '%s' failed on the 'required' tag", field)
+		}
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+	return errors
+}
+
+// requireOrderFields reports the fields a new order is missing.
+func requireOrderFields(request model.OrderInput) map[string]string {
+	if request.Product == nil || *request.Product == "" {
+		return map[string]string{
+			"Product": "Field validation for 'Product' failed on the 'required' tag",
+		}
+	}
+	return nil
+}
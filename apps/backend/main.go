@@ -8,9 +8,11 @@ import (
 
 	"github.com/spf13/cobra"
 	"github.com/theCompanyDream/id-trials/apps/backend/cmd"
+	"github.com/theCompanyDream/id-trials/apps/backend/config"
 	"github.com/theCompanyDream/id-trials/apps/backend/controller"
 	"github.com/theCompanyDream/id-trials/apps/backend/models"
 	"github.com/theCompanyDream/id-trials/apps/backend/repository"
+	"gorm.io/gorm"
 )
 
 var rootCmd = &cobra.Command{
@@ -19,16 +21,30 @@ var rootCmd = &cobra.Command{
 	Long:  `A comprehensive tool for benchmarking different ID types (ULID, KSUID, UUID4, etc.) in database operations.`,
 }
 
+// loadConfig loads the configuration from the --config file, the environment
+// and the flags of command, exiting when it is invalid.
+func loadConfig(command *cobra.Command) *config.Config {
+	path, _ := command.Flags().GetString("config")
+	cfg, err := config.Load(path, command.Flags())
+	if err != nil {
+		log.Fatal(err)
+	}
+	return cfg
+}
+
 var serverCmd = &cobra.Command{
 	Use:   "server",
 	Short: "Start the web API server",
-	Long:  `Starts the web server with REST API endpoints for all ID types.`,
+	Long:  `Starts the web server with REST API endpoints for all ID types, and the gRPC server on its own port.`,
 	Run: func(command *cobra.Command, args []string) {
-		db, err := repository.InitDB()
+		cfg := loadConfig(command)
+		db, err := repository.InitDB(cfg)
 		if err != nil {
 			log.Fatal(err)
 		}
-		controller.RunServer(db)
+		if err := controller.RunServer(db, cfg); err != nil {
+			log.Fatal(err)
+		}
 	},
 }
 
@@ -39,18 +55,205 @@ var generateCmd = &cobra.Command{
 	Run: func(command *cobra.Command, args []string) {
 		records, _ := command.Flags().GetInt("records")
 		batch, _ := command.Flags().GetInt("batch")
+		orders, _ := command.Flags().GetInt("orders")
+		useCopy, _ := command.Flags().GetBool("copy")
+		seed, _ := command.Flags().GetInt64("seed")
+		resume, _ := command.Flags().GetString("resume")
+		retries, _ := command.Flags().GetInt("retries")
+		types, _ := command.Flags().GetStringSlice("types")
+		truncate, _ := command.Flags().GetBool("truncate")
+		untilSize, _ := command.Flags().GetString("until-size")
+
+		var targetSize int64
+		if untilSize != "" {
+			size, err := cmd.ParseSize(untilSize)
+			if err != nil {
+				log.Fatal(err)
+			}
+			targetSize = size
+		}
+
+		config := &models.CmdConfig{
+			RecordsPerTable: records,
+			BatchSize:       batch,
+			OrdersPerUser:   orders,
+			UseCopy:         useCopy,
+			Seed:            seed,
+			Resume:          resume,
+			Retries:         retries,
+			IDTypes:         types,
+			Truncate:        truncate,
+			UntilSize:       targetSize,
+		}
+
+		db, err := repository.InitDB(loadConfig(command))
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		if err := cmd.GenerateData(config, db); err != nil {
+			log.Fatal(err)
+		}
+	},
+}
+
+var resetCmd = &cobra.Command{
+	Use:   "reset",
+	Short: "Empty or recreate the ID tables and metrics",
+	Long:  `Truncates the users and orders tables of the selected ID types and every metrics table, or drops and recreates them with --drop.`,
+	Run: func(command *cobra.Command, args []string) {
+		types, _ := command.Flags().GetStringSlice("types")
+		drop, _ := command.Flags().GetBool("drop")
+		keepMetrics, _ := command.Flags().GetBool("keep-metrics")
+
+		config := &models.CmdConfig{
+			IDTypes:     types,
+			Drop:        drop,
+			KeepMetrics: keepMetrics,
+		}
+
+		db, err := repository.InitDB(loadConfig(command))
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		if err := cmd.ResetData(config, db); err != nil {
+			log.Fatal(err)
+		}
+	},
+}
+
+var migrateCmd = &cobra.Command{
+	Use:   "migrate",
+	Short: "Apply, roll back or list schema migrations",
+	Long:  `Manages the versioned schema migrations built into the binary. Every other command refuses to run until the database is at the latest version.`,
+}
+
+// migrationDB connects without the schema check, so mismatched databases can be migrated.
+func migrationDB(command *cobra.Command) *gorm.DB {
+	db, err := repository.Connect(loadConfig(command).Database)
+	if err != nil {
+		log.Fatal(err)
+	}
+	return db
+}
+
+var migrateUpCmd = &cobra.Command{
+	Use:   "up",
+	Short: "Apply pending migrations",
+	Run: func(command *cobra.Command, args []string) {
+		steps, _ := command.Flags().GetInt("steps")
+		if err := cmd.MigrateUp(migrationDB(command), steps); err != nil {
+			log.Fatal(err)
+		}
+	},
+}
+
+var migrateDownCmd = &cobra.Command{
+	Use:   "down",
+	Short: "Roll back applied migrations, newest first",
+	Run: func(command *cobra.Command, args []string) {
+		steps, _ := command.Flags().GetInt("steps")
+		if all, _ := command.Flags().GetBool("all"); all {
+			steps = 0
+		} else if steps <= 0 {
+			log.Fatal("--steps must be at least 1; use --all to roll back every migration")
+		}
+		if err := cmd.MigrateDown(migrationDB(command), steps); err != nil {
+			log.Fatal(err)
+		}
+	},
+}
+
+var migrateStatusCmd = &cobra.Command{
+	Use:   "status",
+	Short: "List migrations and whether they are applied",
+	Run: func(command *cobra.Command, args []string) {
+		if err := cmd.MigrationStatus(migrationDB(command), os.Stdout); err != nil {
+			log.Fatal(err)
+		}
+	},
+}
+
+var benchmarkCmd = &cobra.Command{
+	Use:   "benchmark",
+	Short: "Measure write amplification per ID type",
+	Long:  `Inserts a controlled batch into every ID table and records WAL bytes, page splits and buffer usage per ID type.`,
+	Run: func(command *cobra.Command, args []string) {
+		records, _ := command.Flags().GetInt("records")
+		batch, _ := command.Flags().GetInt("batch")
+		seed, _ := command.Flags().GetInt64("seed")
 
 		config := &models.CmdConfig{
 			RecordsPerTable: records,
 			BatchSize:       batch,
+			Seed:            seed,
 		}
 
-		db, err := repository.InitDB()
+		db, err := repository.InitDB(loadConfig(command))
 		if err != nil {
 			log.Fatal(err)
 		}
 
-		cmd.GenerateData(config, db)
+		cmd.RunWriteBenchmark(config, db)
+	},
+}
+
+var partitionCmd = &cobra.Command{
+	Use:   "partition",
+	Short: "Maintain the monthly partitions of the partitioned users tables",
+	Long:  `Adds one partition per month around the current month to the partitioned ULID, KSUID and Snowflake users tables that the migrations create, and optionally backfills them. Safe to run repeatedly, e.g. monthly.`,
+	Run: func(command *cobra.Command, args []string) {
+		monthsBack, _ := command.Flags().GetInt("months-back")
+		monthsAhead, _ := command.Flags().GetInt("months-ahead")
+		backfill, _ := command.Flags().GetBool("backfill")
+
+		config := &models.CmdConfig{
+			MonthsBack:  monthsBack,
+			MonthsAhead: monthsAhead,
+			Backfill:    backfill,
+		}
+
+		db, err := repository.InitDB(loadConfig(command))
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		if err := cmd.MaintainPartitions(config, db); err != nil {
+			log.Fatal(err)
+		}
+	},
+}
+
+var importCmd = &cobra.Command{
+	Use:   "import",
+	Short: "Import users from an NDJSON or CSV file",
+	Long:  `Loads the same user records into one or all ID tables, either keeping the supplied IDs or generating new ones per ID type.`,
+	Run: func(command *cobra.Command, args []string) {
+		file, _ := command.Flags().GetString("file")
+		format, _ := command.Flags().GetString("format")
+		types, _ := command.Flags().GetStringSlice("types")
+		preserve, _ := command.Flags().GetBool("preserve-ids")
+		mapping, _ := command.Flags().GetString("mapping")
+		batch, _ := command.Flags().GetInt("batch")
+
+		config := &models.CmdConfig{
+			ImportFile:   file,
+			ImportFormat: format,
+			IDTypes:      types,
+			PreserveIDs:  preserve,
+			MappingFile:  mapping,
+			BatchSize:    batch,
+		}
+
+		db, err := repository.InitDB(loadConfig(command))
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		if err := cmd.ImportData(config, db); err != nil {
+			log.Fatal(err)
+		}
 	},
 }
 
@@ -77,25 +280,70 @@ var loadTestCmd = &cobra.Command{
 }
 
 func init() {
+	rootCmd.PersistentFlags().String("config", "", "YAML config file (default: CONFIG_FILE environment variable)")
+	rootCmd.PersistentFlags().StringP("database", "d", "", "Database connection string, overriding database.url")
+
 	// Server command flags
-	serverCmd.Flags().StringP("port", "p", os.Getenv("BACKEND_PORT"), "Port to run server on")
-	serverCmd.Flags().StringP("host", "H", os.Getenv("BACKEND_HOST"), "Host to bind server to")
+	serverCmd.Flags().IntP("port", "p", config.Default().Server.Port, "Port to run server on, overriding server.port")
+	serverCmd.Flags().StringP("host", "H", "", "Host to bind server to, overriding server.host")
+	serverCmd.Flags().Int("grpc-port", config.Default().Server.GRPCPort, "Port to run the gRPC server on, overriding server.grpc_port; 0 disables it")
 
 	// Generate command flags
 	generateCmd.Flags().IntP("records", "r", 10000, "Number of records per table")
 	generateCmd.Flags().IntP("batch", "b", 1000, "Batch size for inserts")
-	generateCmd.Flags().StringP("database", "d", "", "Database connection string")
+	generateCmd.Flags().IntP("orders", "o", 0, "Number of orders generated per user")
+	generateCmd.Flags().Bool("copy", false, "Write rows with COPY FROM STDIN instead of batched INSERTs")
+	generateCmd.Flags().Int64("seed", 0, "Seed for the generated users and orders (default: random, printed at start)")
+	generateCmd.Flags().String("resume", "", "Continue an interrupted run by ID, or the latest one when no ID is given")
+	generateCmd.Flags().Lookup("resume").NoOptDefVal = "latest"
+	generateCmd.Flags().Int("retries", 5, "Retries of a batch that failed with a transient database error")
+	generateCmd.Flags().StringSlice("types", nil, "ID types to generate, e.g. ulid,snowflake (default: all)")
+	generateCmd.Flags().Bool("truncate", false, "Empty the users and orders tables of the selected ID types first")
+	generateCmd.Flags().String("until-size", "", "Grow each users table to a size such as 10GB instead of --records rows")
+
+	// Reset command flags
+	resetCmd.Flags().StringSlice("types", nil, "ID types to reset, e.g. ulid,snowflake (default: all)")
+	resetCmd.Flags().Bool("drop", false, "Drop and recreate the tables instead of truncating them")
+	resetCmd.Flags().Bool("keep-metrics", false, "Leave route metrics, query plans, benchmarks and generation runs alone")
+
+	// Migrate command flags
+	migrateUpCmd.Flags().Int("steps", 0, "Number of migrations to apply (default: all pending)")
+	migrateDownCmd.Flags().Int("steps", 1, "Number of migrations to roll back")
+	migrateDownCmd.Flags().Bool("all", false, "Roll back every applied migration")
+	migrateCmd.AddCommand(migrateUpCmd, migrateDownCmd, migrateStatusCmd)
+
+	// Benchmark command flags
+	benchmarkCmd.Flags().IntP("records", "r", 10000, "Number of records inserted per table")
+	benchmarkCmd.Flags().IntP("batch", "b", 1000, "Batch size for inserts")
+	benchmarkCmd.Flags().Int64("seed", 0, "Seed for the inserted users (default: random)")
+
+	partitionCmd.Flags().Int("months-back", 12, "Monthly partitions created before the current month")
+	partitionCmd.Flags().Int("months-ahead", 3, "Monthly partitions created after the current month")
+	partitionCmd.Flags().Bool("backfill", false, "Copy existing rows into the partitioned tables")
+
+	importCmd.Flags().StringP("file", "f", "", "NDJSON or CSV file of users")
+	importCmd.Flags().String("format", "", "ndjson or csv (default: from the file extension)")
+	importCmd.Flags().StringSlice("types", nil, "ID types to load, e.g. ulid,snowflake (default: all)")
+	importCmd.Flags().Bool("preserve-ids", false, "Keep supplied IDs; records whose ID is not valid for a type are skipped")
+	importCmd.Flags().String("mapping", "id_mapping.csv", "CSV of source to generated IDs, written when IDs are regenerated")
+	importCmd.Flags().IntP("batch", "b", 1000, "Batch size for inserts")
+	importCmd.MarkFlagRequired("file")
 
-	loadTestCmd.Flags().IntP("records", "r", 10, "Number of requests * 6")
-	loadTestCmd.Flags().IntP("batch", "b", 10, "how many inserts between requests")
+	loadTestCmd.Flags().IntP("records", "r", 10, "Number of users created per endpoint")
+	loadTestCmd.Flags().IntP("batch", "b", 10, "Users sent per bulk create request")
 	loadTestCmd.Flags().IntP("concurrent", "c", 3, "how many concurrent requets")
 	loadTestCmd.Flags().DurationP("timeout", "t", 10*time.Second, "request timeout")
-	loadTestCmd.Flags().DurationP("delay", "d", 10*time.Second, "seconds delayed between requests")
+	loadTestCmd.Flags().Duration("delay", 10*time.Second, "seconds delayed between requests")
 
 	// Add commands to root
 	rootCmd.AddCommand(serverCmd)
 	rootCmd.AddCommand(generateCmd)
+	rootCmd.AddCommand(resetCmd)
+	rootCmd.AddCommand(migrateCmd)
 	rootCmd.AddCommand(loadTestCmd)
+	rootCmd.AddCommand(benchmarkCmd)
+	rootCmd.AddCommand(partitionCmd)
+	rootCmd.AddCommand(importCmd)
 }
 
 func main() {
@@ -1,35 +1,71 @@
 package middleware
 
 import (
+	"context"
+	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/labstack/echo/v4"
 	"github.com/theCompanyDream/id-trials/apps/backend/models"
+	"github.com/theCompanyDream/id-trials/apps/backend/repository"
+	"github.com/theCompanyDream/id-trials/apps/backend/utils"
 	"gorm.io/gorm"
 )
 
+// HeaderExplainAnalyze opts a single request into query plan capture.
+const HeaderExplainAnalyze = "X-Explain-Analyze"
+
+// Conditional request headers (RFC 9110), which Echo does not define.
+const (
+	HeaderETag        = "ETag"
+	HeaderIfMatch     = "If-Match"
+	HeaderIfNoneMatch = "If-None-Match"
+)
+
 type MetricsMiddleware struct {
 	DB *gorm.DB
+	// ExplainAll captures query plans for every request, not only opted-in ones
+	ExplainAll bool
+
+	// pending counts metrics still being written in the background
+	pending sync.WaitGroup
+
+	// writeErr is the error of the latest metric write, nil once one succeeds
+	mu       sync.Mutex
+	writeErr error
 }
 
-func NewMetricsMiddleware(db *gorm.DB) *MetricsMiddleware {
-	return &MetricsMiddleware{DB: db}
+func NewMetricsMiddleware(db *gorm.DB, explainAll bool) *MetricsMiddleware {
+	return &MetricsMiddleware{DB: db, ExplainAll: explainAll}
 }
 
 func (m *MetricsMiddleware) CaptureMetrics() echo.MiddlewareFunc {
 	return func(next echo.HandlerFunc) echo.HandlerFunc {
 		return func(c echo.Context) error {
 			start := time.Now()
+			poolBefore := poolStats(m.DB)
 
 			// Store start time in context for DB timing
 			c.Set("metrics_start", start)
 
-			// Call the handler
+			// Explain repository queries when requested globally or by header
+			var plans *repository.QueryPlanCollector
+			if explain, _ := strconv.ParseBool(c.Request().Header.Get(HeaderExplainAnalyze)); explain || m.ExplainAll {
+				plans = repository.NewQueryPlanCollector()
+				c.SetRequest(c.Request().WithContext(repository.WithQueryPlans(c.Request().Context(), plans)))
+			}
+
+			// Call the handler and render its error now, so the metric records
+			// the status the client actually receives
 			err := next(c)
+			if err != nil {
+				c.Error(err)
+			}
 
-			// Calculate duration
-			duration := time.Since(start)
+			// Calculate duration, leaving out the time spent explaining
+			duration := time.Since(start) - plans.Overhead()
 
 			// Get DB query duration if available
 			dbDuration := float64(0)
@@ -47,6 +83,9 @@ func (m *MetricsMiddleware) CaptureMetrics() echo.MiddlewareFunc {
 					RoutePath:       c.Path(),
 					HTTPMethod:      c.Request().Method,
 					IDType:          idType,
+					Protocol:        models.ProtocolHTTP,
+					StorageMode:     repository.StorageMode(idType),
+					SearchMode:      "none",
 					TotalDuration:   float64(duration.Milliseconds()),
 					DBQueryDuration: dbDuration,
 					HandlerDuration: float64(duration.Milliseconds()) - dbDuration,
@@ -59,27 +98,107 @@ func (m *MetricsMiddleware) CaptureMetrics() echo.MiddlewareFunc {
 					IPAddress:       c.RealIP(),
 				}
 
+				recordPool(&metric, poolBefore, poolStats(m.DB))
+
+				if c.QueryParam("search") != "" {
+					metric.SearchMode = repository.SearchMode()
+				}
+
+				if c.Request().Header.Get(HeaderIfMatch) != "" || c.Request().Header.Get(HeaderIfNoneMatch) != "" {
+					metric.Conditional = true
+				}
+
 				if err != nil {
 					metric.ErrorMessage = err.Error()
 				}
 
-				// Save asynchronously to avoid slowing down response
-				go m.saveMetric(metric)
+				m.record(metric, plans)
 			}
 
-			return err
+			// The error has already been handled above
+			return nil
 		}
 	}
 }
 
-func (m *MetricsMiddleware) saveMetric(metric models.RouteMetric) {
-	if err := m.DB.Create(&metric).Error; err != nil {
+// record saves metric and its query plans asynchronously, to avoid slowing
+// down the response.
+func (m *MetricsMiddleware) record(metric models.RouteMetric, plans *repository.QueryPlanCollector) {
+	m.pending.Add(1)
+	go func() {
+		defer m.pending.Done()
+		m.saveMetric(metric, plans.Plans())
+	}()
+}
+
+// Flush waits until every metric captured so far is written, or ctx is done.
+func (m *MetricsMiddleware) Flush(ctx context.Context) error {
+	done := make(chan struct{})
+	go func() {
+		m.pending.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Healthy returns the error of the latest metric write, or nil when it
+// succeeded or nothing has been written yet.
+func (m *MetricsMiddleware) Healthy() error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.writeErr
+}
+
+func (m *MetricsMiddleware) saveMetric(metric models.RouteMetric, plans []repository.CapturedPlan) {
+	err := m.DB.Create(&metric).Error
+	m.mu.Lock()
+	m.writeErr = err
+	m.mu.Unlock()
+	if err != nil {
 		// Log error but don't fail the request
 		println("Failed to save metric:", err.Error())
+		return
+	}
+
+	for _, captured := range plans {
+		summary, err := utils.SummarizePlan(captured.Plan)
+		if err != nil {
+			println("Failed to summarize plan:", err.Error())
+			continue
+		}
+
+		plan := models.QueryPlan{
+			RouteMetricID:    metric.ID,
+			IDType:           metric.IDType,
+			Operation:        captured.Operation,
+			Table:            captured.Table,
+			Query:            captured.Query,
+			Plan:             captured.Plan,
+			RootNode:         summary.RootNode,
+			UsesIndexScan:    summary.UsesIndexScan,
+			UsesSeqScan:      summary.UsesSeqScan,
+			SharedHitBlocks:  summary.SharedHitBlocks,
+			SharedReadBlocks: summary.SharedReadBlocks,
+			PlanningTime:     summary.PlanningTime,
+			ExecutionTime:    summary.ExecutionTime,
+			Timestamp:        metric.Timestamp,
+		}
+		if err := m.DB.Create(&plan).Error; err != nil {
+			println("Failed to save query plan:", err.Error())
+		}
 	}
 }
 
+// ExtractIDType returns the ID type a route path or gRPC method is for, e.g.
+// ULID for /api/v1/ids/ulid/users or /idtrials.v1.UlidUsers/GetUser.
 func ExtractIDType(path string) string {
+	path = strings.ToLower(path)
 	switch {
 	case strings.Contains(path, "ulid"):
 		return "ULID"
@@ -0,0 +1,29 @@
+package middleware
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/labstack/echo/v4"
+)
+
+// Deprecation headers (RFC 9745, RFC 8288).
+const (
+	HeaderDeprecation = "Deprecation"
+	HeaderLink        = "Link"
+)
+
+// Deprecated marks every response of a route as deprecated since the given
+// time, linking to the route that replaces it.
+func Deprecated(since time.Time, successor string) echo.MiddlewareFunc {
+	deprecation := fmt.Sprintf("@%d", since.Unix())
+	link := fmt.Sprintf(`<%s>; rel="successor-version"`, successor)
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			header := c.Response().Header()
+			header.Set(HeaderDeprecation, deprecation)
+			header.Add(HeaderLink, link)
+			return next(c)
+		}
+	}
+}
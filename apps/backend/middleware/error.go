@@ -1,41 +1,81 @@
 package middleware
 
 import (
+	"errors"
 	"net/http"
 	"runtime/debug"
 
 	"github.com/labstack/echo/v4"
+	"github.com/theCompanyDream/id-trials/apps/backend/models"
+	"github.com/theCompanyDream/id-trials/apps/backend/repository"
 )
 
-func HttpErrorHandler(err error, c echo.Context) {
-	code := http.StatusInternalServerError
-	message := "Internal server error"
-
-	// Check if it's an Echo HTTPError
-	if he, ok := err.(*echo.HTTPError); ok {
-		code = he.Code
-		if msg, ok := he.Message.(string); ok {
-			message = msg
+// MIMEApplicationProblemJSON is the media type of RFC 7807 problem details.
+const MIMEApplicationProblemJSON = "application/problem+json"
+
+// domainStatus maps each repository error kind to its HTTP status.
+var domainStatus = map[repository.ErrorKind]int{
+	repository.KindNotFound:   http.StatusNotFound,
+	repository.KindConflict:   http.StatusConflict,
+	repository.KindInvalidID:  http.StatusBadRequest,
+	repository.KindValidation: http.StatusUnprocessableEntity,
+
+	repository.KindPreconditionFailed: http.StatusPreconditionFailed,
+}
+
+// NewProblem converts err into RFC 7807 problem details. Errors that are neither
+// domain errors nor Echo HTTP errors become an opaque 500.
+func NewProblem(err error) models.Problem {
+	problem := models.Problem{
+		Type:   "about:blank",
+		Status: http.StatusInternalServerError,
+		Detail: "Internal server error",
+	}
+
+	var domainErr *repository.DomainError
+	var httpErr *echo.HTTPError
+	switch {
+	case errors.As(err, &domainErr):
+		if status, ok := domainStatus[domainErr.Kind]; ok {
+			problem.Status = status
+			problem.Detail = domainErr.Message
+			problem.Errors = domainErr.Fields
+		}
+	case errors.As(err, &httpErr):
+		problem.Status = httpErr.Code
+		if msg, ok := httpErr.Message.(string); ok {
+			problem.Detail = msg
 		}
 	}
 
+	problem.Title = http.StatusText(problem.Status)
+	return problem
+}
+
+func HttpErrorHandler(err error, c echo.Context) {
+	problem := NewProblem(err)
+	problem.Instance = c.Request().URL.Path
+	problem.RequestID = c.Response().Header().Get(echo.HeaderXRequestID)
+
 	// ✅ Use your zerolog Logger instead of gommon/log
-	Logger.LogError().
+	event := Logger.LogError().
 		Str("method", c.Request().Method).
 		Str("uri", c.Request().URL.Path).
+		Str("request_id", problem.RequestID).
 		Str("error", err.Error()).
-		Int("status_code", code).
-		Str("stack_trace", string(debug.Stack())).
-		Msg("HTTP Error")
+		Int("status_code", problem.Status)
+	if problem.Status >= http.StatusInternalServerError {
+		event = event.Str("stack_trace", string(debug.Stack()))
+	}
+	event.Msg("HTTP Error")
 
 	// Respond to client
 	if !c.Response().Committed {
 		if c.Request().Method == http.MethodHead {
-			c.NoContent(code)
+			c.NoContent(problem.Status)
 		} else {
-			c.JSON(code, map[string]string{
-				"error": message,
-			})
+			c.Response().Header().Set(echo.HeaderContentType, MIMEApplicationProblemJSON)
+			c.JSON(problem.Status, problem)
 		}
 	}
 }
@@ -0,0 +1,214 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"runtime/debug"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/labstack/gommon/random"
+	"github.com/theCompanyDream/id-trials/apps/backend/models"
+	idtrialsv1 "github.com/theCompanyDream/id-trials/apps/backend/proto/idtrials/v1"
+	"github.com/theCompanyDream/id-trials/apps/backend/repository"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// Metadata the gRPC server reads and sets, the lowercase forms of the HTTP
+// headers.
+const (
+	MetadataRequestID      = "x-request-id"
+	MetadataExplainAnalyze = "x-explain-analyze"
+)
+
+// grpcCodes maps each repository error kind to its gRPC status code.
+var grpcCodes = map[repository.ErrorKind]codes.Code{
+	repository.KindNotFound:   codes.NotFound,
+	repository.KindConflict:   codes.AlreadyExists,
+	repository.KindInvalidID:  codes.InvalidArgument,
+	repository.KindValidation: codes.InvalidArgument,
+
+	repository.KindPreconditionFailed: codes.FailedPrecondition,
+}
+
+// NewStatus converts err into a gRPC status, as NewProblem does for HTTP. The
+// fields of invalid IDs and validation failures become BadRequest details.
+// Errors that are neither domain errors nor statuses become an opaque Internal.
+func NewStatus(err error) *status.Status {
+	if s, ok := status.FromError(err); ok {
+		return s
+	}
+
+	var domainErr *repository.DomainError
+	if !errors.As(err, &domainErr) {
+		return status.New(codes.Internal, "Internal server error")
+	}
+	code, ok := grpcCodes[domainErr.Kind]
+	if !ok {
+		return status.New(codes.Internal, "Internal server error")
+	}
+
+	s := status.New(code, domainErr.Message)
+	if len(domainErr.Fields) == 0 {
+		return s
+	}
+	fields := make([]string, 0, len(domainErr.Fields))
+	for field := range domainErr.Fields {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+	details := &errdetails.BadRequest{}
+	for _, field := range fields {
+		details.FieldViolations = append(details.FieldViolations, &errdetails.BadRequest_FieldViolation{
+			Field:       field,
+			Description: domainErr.Fields[field],
+		})
+	}
+	if detailed, err := s.WithDetails(details); err == nil {
+		s = detailed
+	}
+	return s
+}
+
+// GRPCErrorHandler is the gRPC counterpart of HttpErrorHandler: it logs the
+// errors of unary calls and converts them into statuses, and recovers panics
+// as Internal errors.
+func GRPCErrorHandler(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
+	var stack []byte
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic: %v", r)
+			stack = debug.Stack()
+		}
+		if err == nil {
+			return
+		}
+
+		s := NewStatus(err)
+		event := Logger.LogError().
+			Str("method", info.FullMethod).
+			Str("request_id", metadataValue(ctx, MetadataRequestID)).
+			Str("error", err.Error()).
+			Str("code", s.Code().String())
+		if stack != nil {
+			event = event.Str("stack_trace", string(stack))
+		}
+		event.Msg("gRPC Error")
+		err = s.Err()
+	}()
+	return handler(ctx, req)
+}
+
+// GRPCRequestID is the gRPC counterpart of Echo's RequestID middleware: it
+// keeps the x-request-id the client sent, or generates one, and returns it in
+// the response headers.
+func GRPCRequestID(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	requestID := metadataValue(ctx, MetadataRequestID)
+	if requestID == "" {
+		requestID = random.String(32)
+		md, _ := metadata.FromIncomingContext(ctx)
+		md = md.Copy()
+		md.Set(MetadataRequestID, requestID)
+		ctx = metadata.NewIncomingContext(ctx, md)
+	}
+	if err := grpc.SetHeader(ctx, metadata.Pairs(MetadataRequestID, requestID)); err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+// searchRequest is a list request, whose search term sets the search mode.
+type searchRequest interface {
+	GetSearch() string
+}
+
+// writeRequest is a create or update, conditional when it carries a version.
+type writeRequest interface {
+	GetFields() *idtrialsv1.UserFields
+}
+
+// CaptureGRPCMetrics records a RouteMetric for every unary call to an ID
+// type's service, as CaptureMetrics does for HTTP requests. The route is the
+// full method name, the status the HTTP status the error would have had over
+// HTTP, and Conditional is set when the write carries a version.
+func (m *MetricsMiddleware) CaptureGRPCMetrics() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		idType := ExtractIDType(info.FullMethod)
+		if idType == "Unknown" {
+			return handler(ctx, req)
+		}
+
+		start := time.Now()
+		poolBefore := poolStats(m.DB)
+
+		// Explain repository queries when requested globally or by metadata
+		var plans *repository.QueryPlanCollector
+		if explain, _ := strconv.ParseBool(metadataValue(ctx, MetadataExplainAnalyze)); explain || m.ExplainAll {
+			plans = repository.NewQueryPlanCollector()
+			ctx = repository.WithQueryPlans(ctx, plans)
+		}
+
+		resp, err := handler(ctx, req)
+		duration := time.Since(start) - plans.Overhead()
+
+		metric := models.RouteMetric{
+			RoutePath: info.FullMethod,
+			// Every gRPC call is an HTTP/2 POST
+			HTTPMethod:      http.MethodPost,
+			IDType:          idType,
+			Protocol:        models.ProtocolGRPC,
+			StorageMode:     repository.StorageMode(idType),
+			SearchMode:      "none",
+			TotalDuration:   float64(duration.Milliseconds()),
+			HandlerDuration: float64(duration.Milliseconds()),
+			StatusCode:      http.StatusOK,
+			IsError:         err != nil,
+			RequestID:       metadataValue(ctx, MetadataRequestID),
+			Timestamp:       start,
+			UserAgent:       metadataValue(ctx, "user-agent"),
+		}
+
+		recordPool(&metric, poolBefore, poolStats(m.DB))
+
+		if message, ok := resp.(proto.Message); ok && err == nil {
+			metric.ResponseSize = proto.Size(message)
+		}
+		if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+			metric.IPAddress = p.Addr.String()
+			if host, _, splitErr := net.SplitHostPort(metric.IPAddress); splitErr == nil {
+				metric.IPAddress = host
+			}
+		}
+		if search, ok := req.(searchRequest); ok && search.GetSearch() != "" {
+			metric.SearchMode = repository.SearchMode()
+		}
+		if write, ok := req.(writeRequest); ok && write.GetFields().GetVersion() != 0 {
+			metric.Conditional = true
+		}
+		if err != nil {
+			metric.StatusCode = NewProblem(err).Status
+			metric.ErrorMessage = err.Error()
+		}
+
+		m.record(metric, plans)
+		return resp, err
+	}
+}
+
+// metadataValue returns the first value of key in the incoming metadata of ctx.
+func metadataValue(ctx context.Context, key string) string {
+	if values := metadata.ValueFromIncomingContext(ctx, key); len(values) > 0 {
+		return values[0]
+	}
+	return ""
+}
@@ -0,0 +1,79 @@
+package middleware
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/theCompanyDream/id-trials/apps/backend/models"
+	"gorm.io/gorm"
+)
+
+// poolStats returns the connection pool statistics of db, or zero values when
+// db has no *sql.DB underneath.
+func poolStats(db *gorm.DB) sql.DBStats {
+	sqlDB, err := db.DB()
+	if err != nil {
+		return sql.DBStats{}
+	}
+	return sqlDB.Stats()
+}
+
+func milliseconds(d time.Duration) float64 {
+	return float64(d) / float64(time.Millisecond)
+}
+
+// recordPool sets the pool columns of metric from the pool statistics taken
+// when the request arrived and when it finished.
+func recordPool(metric *models.RouteMetric, before, after sql.DBStats) {
+	metric.PoolMaxOpen = after.MaxOpenConnections
+	metric.PoolInUse = before.InUse
+	metric.PoolWaitCount = after.WaitCount - before.WaitCount
+	metric.PoolWaitDuration = milliseconds(after.WaitDuration - before.WaitDuration)
+}
+
+// PoolSampler records the connection pool statistics of DB every Interval,
+// so pool saturation can be read next to request latency.
+type PoolSampler struct {
+	DB       *gorm.DB
+	Interval time.Duration
+}
+
+func NewPoolSampler(db *gorm.DB, interval time.Duration) *PoolSampler {
+	return &PoolSampler{DB: db, Interval: interval}
+}
+
+// Run samples the pool until ctx is done.
+func (s *PoolSampler) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := s.Sample(); err != nil {
+				// Log error but keep sampling
+				println("Failed to save pool stats:", err.Error())
+			}
+		}
+	}
+}
+
+// Sample records the current pool statistics.
+func (s *PoolSampler) Sample() error {
+	stats := poolStats(s.DB)
+	return s.DB.Create(&models.PoolStat{
+		MaxOpen:           stats.MaxOpenConnections,
+		Open:              stats.OpenConnections,
+		InUse:             stats.InUse,
+		Idle:              stats.Idle,
+		WaitCount:         stats.WaitCount,
+		WaitDuration:      milliseconds(stats.WaitDuration),
+		MaxIdleClosed:     stats.MaxIdleClosed,
+		MaxIdleTimeClosed: stats.MaxIdleTimeClosed,
+		MaxLifetimeClosed: stats.MaxLifetimeClosed,
+		Timestamp:         time.Now(),
+	}).Error
+}
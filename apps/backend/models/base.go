@@ -5,13 +5,16 @@ type UserBase struct {
 	FirstName  string  `gorm:"column:first_name;type:varchar(40);not null" json:"first_name"`
 	LastName   string  `gorm:"column:last_name;type:varchar(40);not null" json:"last_name"`
 	Email      string  `gorm:"column:email;type:varchar(40);not null;" json:"email"`
-	Department *string `gorm:"column:department;type:varchar(25)" json:"department"`
+	Department *string `gorm:"column:department;type:varchar(25)" json:"department" extensions:"x-nullable"`
+	// Version increases on every write and backs the ETag of the user
+	Version int64 `gorm:"column:version;not null;default:1" json:"version"`
 }
 
 type UserInput struct {
-	// HashId is the public identifier for the user (UUID).
+	// Id is the public identifier for the user. Its format depends on the ID
+	// type, so each controller validates it against its own validator tag.
 	// For create operations, this might be generated internally.
-	Id *string `json:"id" validate:"omitempty,uuid4" form:"id"`
+	Id *string `json:"id" form:"id"`
 
 	// UserName is required when creating a new user.
 	UserName *string `json:"user_name" validate:"omitempty,min=5,max=50" form:"user_name"`
@@ -26,7 +29,7 @@ type UserInput struct {
 	Email *string `json:"email" validate:"omitempty,email,max=255" form:"email"`
 
 	// Department is optional.
-	Department *string `json:"department" form:"department"`
+	Department *string `json:"department" form:"department" extensions:"x-nullable"`
 }
 
 type UserDTO struct {
@@ -35,7 +38,8 @@ type UserDTO struct {
 	FirstName  string  `json:"first_name"`
 	LastName   string  `json:"last_name"`
 	Email      string  `json:"email"`
-	Department *string `json:"department"`
+	Department *string `json:"department" extensions:"x-nullable"`
+	Version    int64   `json:"version"`
 }
 
 // Paging defines the structure for pagination information
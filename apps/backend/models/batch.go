@@ -0,0 +1,42 @@
+package models
+
+// BatchCreateRequest is the body of a bulk create.
+// @Description BatchCreateRequest
+type BatchCreateRequest struct {
+	// Insert every user or none of them
+	Atomic bool `json:"atomic"`
+	// The users to create
+	Users []UserInput `json:"users"`
+}
+
+// BatchIDsRequest is the body of a bulk read or delete.
+// @Description BatchIDsRequest
+type BatchIDsRequest struct {
+	// Fail the whole request when any ID is invalid or missing
+	Atomic bool `json:"atomic"`
+	// The IDs to read or delete
+	IDs []string `json:"ids"`
+}
+
+// BatchItemResult is the outcome of one item of a bulk request.
+// @Description BatchItemResult
+type BatchItemResult struct {
+	// The position of the item in the request
+	Index int `json:"index"`
+	// The ID of the user, when known
+	ID string `json:"id,omitempty"`
+	// The HTTP status the item would have had as a single request
+	Status int `json:"status"`
+	// The user, for creates and reads
+	User *UserDTO `json:"user,omitempty"`
+	// Why the item failed
+	Error *Problem `json:"error,omitempty"`
+}
+
+// BatchResponse reports every item of a bulk request in request order.
+// @Description BatchResponse
+type BatchResponse struct {
+	Results   []BatchItemResult `json:"results"`
+	Succeeded int               `json:"succeeded"`
+	Failed    int               `json:"failed"`
+}
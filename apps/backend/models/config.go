@@ -6,7 +6,24 @@ type CmdConfig struct {
 	BaseURL          string
 	RecordsPerTable  int
 	BatchSize        int
+	OrdersPerUser    int           // Child orders generated per user (foreign key fan-out)
+	UseCopy          bool          // Write generated rows with COPY FROM STDIN instead of INSERT
+	Seed             int64         // Seeds the generated users and orders; random when 0
+	Resume           string        // Generation run to continue, or "latest"; empty starts a new run
+	Retries          int           // Retries of a batch that failed with a transient error
+	Truncate         bool          // Empty the tables of the selected ID types before generating
+	UntilSize        int64         // Grow each users table to this many bytes instead of RecordsPerTable rows
+	Drop             bool          // Reset by dropping and recreating tables instead of truncating them
+	KeepMetrics      bool          // Leave the metrics tables alone on reset
+	MonthsBack       int           // Monthly partitions created before the current month
+	MonthsAhead      int           // Monthly partitions created after the current month
+	Backfill         bool          // Copy existing rows into the partitioned tables
 	ConcurrentReqs   int           // How many concurrent requests per ID type
 	RequestTimeout   time.Duration // Timeout per request
 	DelayBetweenReqs time.Duration
+	ImportFile       string   // NDJSON or CSV file of users to import
+	ImportFormat     string   // ndjson or csv; taken from the file extension when empty
+	IDTypes          []string // ID types to load, e.g. ulid,snowflake; all when empty
+	PreserveIDs      bool     // Keep supplied IDs instead of generating new ones
+	MappingFile      string   // CSV of source to generated IDs written when IDs are regenerated
 }
@@ -0,0 +1,36 @@
+package models
+
+import (
+	"github.com/jinzhu/copier"
+)
+
+// OrderCUID is an order placed by a UserCUID.
+type OrderCUID struct {
+	ID     string `gorm:"column:id;type:varchar(25);primaryKey" json:"id"`
+	UserID string `gorm:"column:user_id;type:varchar(25);not null;index:idx_orders_cuid_user_id" json:"user_id"`
+	*OrderBase
+	User *UserCUID `gorm:"foreignKey:UserID;references:ID;constraint:OnDelete:CASCADE" json:"-"`
+}
+
+// TableName sets the table name for OrderCUID to "orders_cuid".
+func (OrderCUID) TableName() string {
+	return "orders_cuid"
+}
+
+func InputToOrderCuid(orderCreate OrderInput, userId string) *OrderCUID {
+	var order OrderCUID
+	copier.Copy(&order, &orderCreate)
+	if orderCreate.Id != nil {
+		order.ID = *orderCreate.Id
+	} else {
+		order.ID = ""
+	}
+	order.UserID = userId
+	return &order
+}
+
+func (order *OrderCUID) OrderCuidToDTO() *OrderDTO {
+	var orderDTO OrderDTO
+	copier.Copy(&orderDTO, &order)
+	return &orderDTO
+}
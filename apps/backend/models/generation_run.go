@@ -0,0 +1,51 @@
+package models
+
+import (
+	"time"
+)
+
+// Generation run statuses.
+const (
+	GenerationRunning   = "running"
+	GenerationCompleted = "completed"
+	GenerationFailed    = "failed"
+)
+
+// GenerationRun records the settings of one generate run, so its dataset can
+// be reproduced from the seed and an interrupted run can be resumed.
+type GenerationRun struct {
+	ID uint `gorm:"primaryKey"`
+
+	RunID           string `gorm:"type:varchar(40);not null;uniqueIndex:idx_generation_run"`
+	Seed            int64  `gorm:"not null"`
+	Types           string `gorm:"type:varchar(100);not null"` // Comma-separated ID types; empty for all
+	RecordsPerTable int64  `gorm:"not null"`                   // 0 when growing to TargetSize
+	TargetSize      int64  `gorm:"not null"`                   // Bytes each users table grows to; 0 for a record count
+	BatchSize       int64  `gorm:"not null"`
+	OrdersPerUser   int64  `gorm:"not null"`
+	Method          string `gorm:"type:varchar(20);not null"` // insert or copy
+	Status          string `gorm:"type:varchar(20);not null;index:idx_generation_status"`
+
+	Duration  float64   `gorm:"not null"` // Time spent generating in milliseconds, summed over resumes
+	Timestamp time.Time `gorm:"not null;index:idx_generation_timestamp"`
+}
+
+func (GenerationRun) TableName() string {
+	return "generation_runs"
+}
+
+// GenerationCheckpoint is the progress of one ID type in a generation run. It
+// is written in the same transaction as each batch, so Completed users (and
+// their orders) are exactly the rows in the table.
+type GenerationCheckpoint struct {
+	ID uint `gorm:"primaryKey"`
+
+	RunID     string    `gorm:"type:varchar(40);not null;uniqueIndex:idx_checkpoint_run_type"`
+	IDType    string    `gorm:"type:varchar(20);not null;uniqueIndex:idx_checkpoint_run_type"`
+	Completed int64     `gorm:"not null"`
+	UpdatedAt time.Time `gorm:"not null"`
+}
+
+func (GenerationCheckpoint) TableName() string {
+	return "generation_checkpoints"
+}
@@ -0,0 +1,46 @@
+package models
+
+import "time"
+
+// HealthStatus is the body of the liveness and readiness probes.
+// @Description HealthStatus
+type HealthStatus struct {
+	// ok, ready, draining or unavailable
+	Status string `json:"status"`
+	// "ok" or the failure of each readiness check
+	Checks map[string]string `json:"checks,omitempty"`
+}
+
+// DebugInfo describes the running server.
+// @Description DebugInfo
+type DebugInfo struct {
+	Version       string    `json:"version"`
+	Revision      string    `json:"revision,omitempty"` // VCS revision the binary was built from
+	GoVersion     string    `json:"go_version"`
+	StartedAt     time.Time `json:"started_at"`
+	UptimeSeconds float64   `json:"uptime_seconds"`
+	// ID types with registered routes
+	IDTypes []IDTypeRoutes `json:"id_types"`
+	Pool    PoolInfo       `json:"pool"`
+	// The configuration, keyed like the config file, without secrets
+	Config map[string]interface{} `json:"config"`
+}
+
+// IDTypeRoutes counts the routes registered for one ID type.
+type IDTypeRoutes struct {
+	IDType string `json:"id_type"`
+	Routes int    `json:"routes"`
+}
+
+// PoolInfo is the current state of the database connection pool.
+type PoolInfo struct {
+	MaxOpen           int     `json:"max_open"` // 0 when unlimited
+	Open              int     `json:"open"`
+	InUse             int     `json:"in_use"`
+	Idle              int     `json:"idle"`
+	WaitCount         int64   `json:"wait_count"`
+	WaitDuration      float64 `json:"wait_duration"` // milliseconds
+	MaxIdleClosed     int64   `json:"max_idle_closed"`
+	MaxIdleTimeClosed int64   `json:"max_idle_time_closed"`
+	MaxLifetimeClosed int64   `json:"max_lifetime_closed"`
+}
@@ -0,0 +1,36 @@
+package models
+
+import (
+	"github.com/jinzhu/copier"
+)
+
+// OrderKSUID is an order placed by a UserKSUID.
+type OrderKSUID struct {
+	ID     string `gorm:"column:id;type:varchar(27);primaryKey" json:"id"`
+	UserID string `gorm:"column:user_id;type:varchar(27);not null;index:idx_orders_ksuid_user_id" json:"user_id"`
+	*OrderBase
+	User *UserKSUID `gorm:"foreignKey:UserID;references:ID;constraint:OnDelete:CASCADE" json:"-"`
+}
+
+// TableName sets the table name for OrderKSUID to "orders_ksuid".
+func (OrderKSUID) TableName() string {
+	return "orders_ksuid"
+}
+
+func InputToOrderKSUID(orderCreate OrderInput, userId string) *OrderKSUID {
+	var order OrderKSUID
+	copier.Copy(&order, &orderCreate)
+	if orderCreate.Id != nil {
+		order.ID = *orderCreate.Id
+	} else {
+		order.ID = ""
+	}
+	order.UserID = userId
+	return &order
+}
+
+func (order *OrderKSUID) OrderKsuidToDTO() *OrderDTO {
+	var orderDTO OrderDTO
+	copier.Copy(&orderDTO, &order)
+	return &orderDTO
+}
@@ -0,0 +1,36 @@
+package models
+
+import (
+	"github.com/jinzhu/copier"
+)
+
+// OrderNanoID is an order placed by a UserNanoID.
+type OrderNanoID struct {
+	ID     string `gorm:"column:id;type:varchar(27);primaryKey" json:"id"`
+	UserID string `gorm:"column:user_id;type:varchar(27);not null;index:idx_orders_nanoid_user_id" json:"user_id"`
+	*OrderBase
+	User *UserNanoID `gorm:"foreignKey:UserID;references:ID;constraint:OnDelete:CASCADE" json:"-"`
+}
+
+// TableName sets the table name for OrderNanoID to "orders_nanoid".
+func (OrderNanoID) TableName() string {
+	return "orders_nanoid"
+}
+
+func InputToOrderNanoId(orderCreate OrderInput, userId string) *OrderNanoID {
+	var order OrderNanoID
+	copier.Copy(&order, &orderCreate)
+	if orderCreate.Id != nil {
+		order.ID = *orderCreate.Id
+	} else {
+		order.ID = ""
+	}
+	order.UserID = userId
+	return &order
+}
+
+func (order *OrderNanoID) OrderNanoIdToDTO() *OrderDTO {
+	var orderDTO OrderDTO
+	copier.Copy(&orderDTO, &order)
+	return &orderDTO
+}
@@ -0,0 +1,50 @@
+package models
+
+import "time"
+
+// OrderBase holds the columns shared by every orders table. Each orders table
+// references the users table of the same ID type, so the foreign key column
+// has the same type as the parent's primary key.
+type OrderBase struct {
+	Product    string    `gorm:"column:product;type:varchar(60);not null" json:"product"`
+	Quantity   int       `gorm:"column:quantity;not null;default:1" json:"quantity"`
+	TotalCents int64     `gorm:"column:total_cents;not null" json:"total_cents"`
+	Status     string    `gorm:"column:status;type:varchar(20);not null" json:"status"`
+	CreatedAt  time.Time `gorm:"column:created_at;not null" json:"created_at"`
+}
+
+type OrderInput struct {
+	// Id is the public identifier of the order.
+	Id *string `json:"id" form:"id"`
+
+	// Product is required when creating a new order.
+	Product *string `json:"product" validate:"omitempty,min=2,max=60" form:"product"`
+
+	// Quantity defaults to 1.
+	Quantity *int `json:"quantity" validate:"omitempty,min=1" form:"quantity"`
+
+	// TotalCents is the order total in cents.
+	TotalCents *int64 `json:"total_cents" validate:"omitempty,min=0" form:"total_cents"`
+
+	// Status is one of pending, paid, shipped or cancelled.
+	Status *string `json:"status" validate:"omitempty,oneof=pending paid shipped cancelled" form:"status"`
+}
+
+type OrderDTO struct {
+	ID         string    `json:"id"`
+	UserID     string    `json:"user_id"`
+	UserName   string    `json:"user_name"`
+	Product    string    `json:"product"`
+	Quantity   int       `json:"quantity"`
+	TotalCents int64     `json:"total_cents"`
+	Status     string    `json:"status"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+// OrderPaging defines the structure for order pagination which includes the orders and pagination details
+// @Description OrderPaging
+type OrderPaging struct {
+	Paging // Embedded Paging struct
+	// A list of orders
+	Orders []OrderDTO `json:"orders"`
+}
@@ -0,0 +1,32 @@
+package models
+
+// Partitioned variants of the users tables for the time-ordered ID types.
+// The id column uses the "C" collation so range bounds follow byte order,
+// which is the order the IDs encode their timestamps in.
+
+type UserUlidPartitioned struct {
+	ID string `gorm:"column:id;type:varchar(26) COLLATE \"C\";primaryKey" json:"id"`
+	*UserBase
+}
+
+func (UserUlidPartitioned) TableName() string {
+	return "users_ulid_part"
+}
+
+type UserKSUIDPartitioned struct {
+	ID string `gorm:"column:id;type:varchar(27) COLLATE \"C\";primaryKey" json:"id"`
+	*UserBase
+}
+
+func (UserKSUIDPartitioned) TableName() string {
+	return "users_ksuid_part"
+}
+
+type UserSnowflakePartitioned struct {
+	ID int64 `gorm:"column:id;type:bigint;primaryKey;autoIncrement:false" json:"id"`
+	*UserBase
+}
+
+func (UserSnowflakePartitioned) TableName() string {
+	return "users_snowflake_part"
+}
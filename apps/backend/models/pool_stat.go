@@ -0,0 +1,32 @@
+package models
+
+import (
+	"time"
+)
+
+// PoolStat is a periodic sample of the database connection pool. The wait
+// and closed counters are cumulative since the server started.
+type PoolStat struct {
+	ID uint `gorm:"primaryKey"`
+
+	// Connections
+	MaxOpen int `gorm:"not null"` // Pool size limit, 0 when unlimited
+	Open    int `gorm:"not null"`
+	InUse   int `gorm:"not null"`
+	Idle    int `gorm:"not null"`
+
+	// Waits for a free connection
+	WaitCount    int64   `gorm:"not null"`
+	WaitDuration float64 `gorm:"not null"` // milliseconds
+
+	// Connections closed by the pool settings
+	MaxIdleClosed     int64 `gorm:"not null"`
+	MaxIdleTimeClosed int64 `gorm:"not null"`
+	MaxLifetimeClosed int64 `gorm:"not null"`
+
+	Timestamp time.Time `gorm:"not null;index:idx_pool_stat_timestamp"`
+}
+
+func (PoolStat) TableName() string {
+	return "pool_stats"
+}
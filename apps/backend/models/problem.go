@@ -0,0 +1,20 @@
+package models
+
+// Problem is an RFC 7807 problem details response.
+// @Description Problem
+type Problem struct {
+	// A URI identifying the problem type
+	Type string `json:"type" example:"about:blank"`
+	// A short summary of the problem type
+	Title string `json:"title" example:"Not Found"`
+	// The HTTP status code
+	Status int `json:"status" example:"404"`
+	// An explanation specific to this occurrence
+	Detail string `json:"detail,omitempty" example:"user not found"`
+	// The request path
+	Instance string `json:"instance,omitempty" example:"/ulidId/01HZX3K4Q2M8V6T9R5N7B1C0DE"`
+	// The X-Request-ID of the failed request
+	RequestID string `json:"request_id,omitempty"`
+	// Per-field messages for invalid IDs and validation failures
+	Errors map[string]string `json:"errors,omitempty"`
+}
@@ -0,0 +1,34 @@
+package models
+
+import (
+	"time"
+)
+
+// QueryPlan stores an EXPLAIN (ANALYZE, BUFFERS) plan captured for a request,
+// linked to the RouteMetric row of that request.
+type QueryPlan struct {
+	ID            uint `gorm:"primaryKey"`
+	RouteMetricID uint `gorm:"not null;index:idx_plan_route_metric"`
+
+	// Statement Information
+	IDType    string `gorm:"type:varchar(20);not null;index:idx_plan_id_type"`
+	Operation string `gorm:"type:varchar(10);not null"` // query or update
+	Table     string `gorm:"column:table_name;type:varchar(63)"`
+	Query     string `gorm:"type:text;not null"`
+	Plan      string `gorm:"type:jsonb;not null"`
+
+	// Plan Summary
+	RootNode         string  `gorm:"type:varchar(50)"`
+	UsesIndexScan    bool    `gorm:"default:false"`
+	UsesSeqScan      bool    `gorm:"default:false"`
+	SharedHitBlocks  int64   `gorm:"default:0"`
+	SharedReadBlocks int64   `gorm:"default:0"`
+	PlanningTime     float64 `gorm:"default:0"` // milliseconds
+	ExecutionTime    float64 `gorm:"default:0"` // milliseconds
+
+	Timestamp time.Time `gorm:"not null;index:idx_plan_timestamp"`
+}
+
+func (QueryPlan) TableName() string {
+	return "query_plans"
+}
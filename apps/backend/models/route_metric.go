@@ -4,19 +4,37 @@ import (
 	"time"
 )
 
+// Protocols a RouteMetric is tagged with.
+const (
+	ProtocolHTTP = "http"
+	ProtocolGRPC = "grpc"
+)
+
 type RouteMetric struct {
 	ID uint `gorm:"primaryKey"`
 
 	// Route Information
 	RoutePath  string `gorm:"type:varchar(255);not null;index:idx_route_metrics"`
 	HTTPMethod string `gorm:"type:varchar(10);not null"`
-	IDType     string `gorm:"type:varchar(20);not null;index:idx_id_type"` // ULID, UUID, KSUID, etc.
+	IDType     string `gorm:"type:varchar(20);not null;index:idx_id_type"`               // ULID, UUID, KSUID, etc.
+	Protocol   string `gorm:"type:varchar(10);not null;default:http;index:idx_protocol"` // http or grpc
+
+	// Storage Information
+	StorageMode string `gorm:"type:varchar(20);not null;default:heap"` // heap or partitioned
+	SearchMode  string `gorm:"type:varchar(20);not null;default:none"` // none, ilike, trigram or fulltext
+	Conditional bool   `gorm:"not null;default:false"`                 // sent If-Match or If-None-Match
 
 	// Timing Metrics (in milliseconds)
 	TotalDuration   float64 `gorm:"not null"` // Total request time
 	DBQueryDuration float64 `gorm:"not null"` // Database query time only
 	HandlerDuration float64 `gorm:"not null"` // Handler processing time
 
+	// Connection Pool
+	PoolMaxOpen      int     `gorm:"not null;default:0"` // Pool size limit, 0 when unlimited
+	PoolInUse        int     `gorm:"not null;default:0"` // Connections in use when the request arrived
+	PoolWaitCount    int64   `gorm:"not null;default:0"` // Waits for a connection, pool-wide, while the request ran
+	PoolWaitDuration float64 `gorm:"not null;default:0"` // Time spent in those waits, in milliseconds
+
 	// Response Information
 	StatusCode   int `gorm:"not null;index:idx_status"`
 	ResponseSize int `gorm:"default:0"` // Response body size in bytes
@@ -0,0 +1,45 @@
+package models
+
+import (
+	"strconv"
+
+	"github.com/jinzhu/copier"
+)
+
+// OrderSnowflake is an order placed by a UserSnowflake.
+type OrderSnowflake struct {
+	ID     int64 `gorm:"column:id;type:bigint;primaryKey;autoIncrement:false" json:"id"`
+	UserID int64 `gorm:"column:user_id;type:bigint;not null;index:idx_orders_snowflake_user_id" json:"user_id"`
+	*OrderBase
+	User *UserSnowflake `gorm:"foreignKey:UserID;references:ID;constraint:OnDelete:CASCADE" json:"-"`
+}
+
+// TableName sets the table name for OrderSnowflake to "orders_snowflake".
+func (OrderSnowflake) TableName() string {
+	return "orders_snowflake"
+}
+
+func InputToOrderSnowFlake(orderCreate OrderInput, userId string) *OrderSnowflake {
+	var order OrderSnowflake
+	copier.Copy(&order, &orderCreate)
+	order.ID = 0
+
+	if orderCreate.Id != nil {
+		if idInt, err := strconv.ParseInt(*orderCreate.Id, 10, 64); err == nil {
+			order.ID = idInt
+		}
+	}
+	if userInt, err := strconv.ParseInt(userId, 10, 64); err == nil {
+		order.UserID = userInt
+	}
+
+	return &order
+}
+
+func (order *OrderSnowflake) OrderSnowflakeToDTO() *OrderDTO {
+	var orderDTO OrderDTO
+	copier.Copy(&orderDTO, &order)
+	orderDTO.ID = strconv.FormatInt(order.ID, 10)
+	orderDTO.UserID = strconv.FormatInt(order.UserID, 10)
+	return &orderDTO
+}
@@ -0,0 +1,13 @@
+package stats
+
+// ConditionalWrites compares plain and If-Match writes per ID type and method.
+type ConditionalWrites struct {
+	IDType             string  `json:"id_type"`
+	HTTPMethod         string  `json:"http_method"`
+	Conditional        bool    `json:"conditional"`
+	RequestCount       int64   `json:"request_count"`
+	PreconditionFailed int64   `json:"precondition_failed"`
+	AvgDuration        float64 `json:"avg_duration"`
+	P95                float64 `json:"p95"`
+	AvgDBQuery         float64 `json:"avg_db_query_duration"`
+}
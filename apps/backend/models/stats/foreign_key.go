@@ -0,0 +1,16 @@
+package stats
+
+// ForeignKeyCost compares the cost of using an ID type as a foreign key: the
+// size of the orders.user_id index and the latency of the users-orders join.
+type ForeignKeyCost struct {
+	IDType          string  `json:"id_type"`
+	TableName       string  `json:"table_name"`
+	IndexName       string  `json:"index_name"`
+	RowCount        int64   `json:"row_count"`
+	IndexBytes      int64   `json:"index_bytes"`
+	IndexPretty     string  `json:"index_pretty"`
+	BytesPerRow     float64 `json:"bytes_per_row"`
+	JoinRequests    int64   `json:"join_requests"`
+	AvgJoinDuration float64 `json:"avg_join_duration"`
+	P95JoinDuration float64 `json:"p95_join_duration"`
+}
@@ -0,0 +1,23 @@
+package stats
+
+type PartitionInfo struct {
+	Name       string `json:"name"`
+	LowerBound string `json:"lower_bound"`
+	UpperBound string `json:"upper_bound"`
+}
+
+// PartitionPruning compares a recent time-window scan on a partitioned users
+// table with the same scan on its unpartitioned counterpart.
+type PartitionPruning struct {
+	IDType                 string  `json:"id_type"`
+	TableName              string  `json:"table_name"`
+	PartitionCount         int64   `json:"partition_count"`
+	WindowHours            int     `json:"window_hours"`
+	PartitionsScanned      int     `json:"partitions_scanned"`
+	PartitionedExecution   float64 `json:"partitioned_execution_time"`
+	PartitionedBlocks      int64   `json:"partitioned_shared_blocks"`
+	UnpartitionedExecution float64 `json:"unpartitioned_execution_time"`
+	UnpartitionedBlocks    int64   `json:"unpartitioned_shared_blocks"`
+	PartitionedAvgDuration float64 `json:"partitioned_avg_duration"`
+	HeapAvgDuration        float64 `json:"heap_avg_duration"`
+}
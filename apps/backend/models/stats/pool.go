@@ -0,0 +1,34 @@
+package stats
+
+import "time"
+
+// PoolSaturation compares the latency of requests per ID type with the state
+// of the connection pool they met, separating ID cost from connection
+// starvation.
+type PoolSaturation struct {
+	IDType              string  `json:"id_type"`
+	RequestCount        int64   `json:"request_count"`
+	AvgDuration         float64 `json:"avg_duration"`
+	P95                 float64 `json:"p95"`
+	AvgDBQuery          float64 `json:"avg_db_query_duration"`
+	AvgPoolInUse        float64 `json:"avg_pool_in_use"`
+	AvgSaturation       float64 `json:"avg_saturation"` // pool_in_use / pool_max_open, 0 for an unlimited pool
+	WaitedCount         int64   `json:"waited_count"`   // requests during which the pool made callers wait
+	WaitedAvgDuration   float64 `json:"waited_avg_duration"`
+	UnwaitedAvgDuration float64 `json:"unwaited_avg_duration"`
+	AvgPoolWait         float64 `json:"avg_pool_wait"`
+}
+
+// PoolTimelinePoint is one minute of pool samples next to the requests served
+// in that minute.
+type PoolTimelinePoint struct {
+	TimeBucket   time.Time `json:"time_bucket"`
+	MaxOpen      int64     `json:"max_open"`
+	AvgInUse     float64   `json:"avg_in_use"`
+	MaxInUse     int64     `json:"max_in_use"`
+	Waits        int64     `json:"waits"`
+	WaitDuration float64   `json:"wait_duration"`
+	RequestCount int64     `json:"request_count"`
+	AvgDuration  float64   `json:"avg_duration"`
+	P95          float64   `json:"p95"`
+}
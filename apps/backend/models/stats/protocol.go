@@ -0,0 +1,13 @@
+package stats
+
+// ProtocolComparison compares the requests of an ID type over HTTP and gRPC.
+type ProtocolComparison struct {
+	IDType          string  `json:"id_type"`
+	Protocol        string  `json:"protocol"`
+	RequestCount    int64   `json:"request_count"`
+	ErrorCount      int64   `json:"error_count"`
+	AvgDuration     float64 `json:"avg_duration"`
+	Median          float64 `json:"median"`
+	P95             float64 `json:"p95"`
+	AvgResponseSize float64 `json:"avg_response_size"`
+}
@@ -0,0 +1,26 @@
+package stats
+
+// PlanSummary is the condensed form of an EXPLAIN (FORMAT JSON) plan.
+type PlanSummary struct {
+	RootNode         string  `json:"root_node"`
+	UsesIndexScan    bool    `json:"uses_index_scan"`
+	UsesSeqScan      bool    `json:"uses_seq_scan"`
+	SharedHitBlocks  int64   `json:"shared_hit_blocks"`
+	SharedReadBlocks int64   `json:"shared_read_blocks"`
+	PlanningTime     float64 `json:"planning_time"`
+	ExecutionTime    float64 `json:"execution_time"`
+	// Relations lists every table or partition the plan scanned
+	Relations []string `json:"relations"`
+}
+
+type QueryPlanStats struct {
+	IDType           string  `json:"id_type"`
+	Operation        string  `json:"operation"`
+	PlanCount        int64   `json:"plan_count"`
+	IndexScanCount   int64   `json:"index_scan_count"`
+	SeqScanCount     int64   `json:"seq_scan_count"`
+	AvgSharedHit     float64 `json:"avg_shared_hit"`
+	AvgSharedRead    float64 `json:"avg_shared_read"`
+	BufferHitRatio   float64 `json:"buffer_hit_ratio"`
+	AvgExecutionTime float64 `json:"avg_execution_time"`
+}
@@ -0,0 +1,12 @@
+package stats
+
+// SearchPerformance summarizes searched list requests per ID type and search mode.
+type SearchPerformance struct {
+	IDType       string  `json:"id_type"`
+	SearchMode   string  `json:"search_mode"`
+	RequestCount int64   `json:"request_count"`
+	AvgDuration  float64 `json:"avg_duration"`
+	Median       float64 `json:"median"`
+	P95          float64 `json:"p95"`
+	AvgDBQuery   float64 `json:"avg_db_query_duration"`
+}
@@ -0,0 +1,30 @@
+package stats
+
+import "time"
+
+// WriteStatsSnapshot is a point-in-time reading of the Postgres statistics
+// views used to measure the cost of an insert batch on one table.
+type WriteStatsSnapshot struct {
+	WalBytes        int64 `json:"wal_bytes"`
+	WalRecords      int64 `json:"wal_records"`
+	WalFpi          int64 `json:"wal_fpi"`
+	IndexPages      int64 `json:"index_pages"`
+	IndexBlocksRead int64 `json:"index_blocks_read"`
+	IndexBlocksHit  int64 `json:"index_blocks_hit"`
+	HeapBlocksRead  int64 `json:"heap_blocks_read"`
+	HeapBlocksHit   int64 `json:"heap_blocks_hit"`
+}
+
+type WriteAmplification struct {
+	RunID              string    `json:"run_id"`
+	IDType             string    `json:"id_type"`
+	TableName          string    `json:"table_name"`
+	Records            int64     `json:"records"`
+	WalBytesPerInsert  float64   `json:"wal_bytes_per_insert"`
+	WalFpiPerInsert    float64   `json:"wal_fpi_per_insert"`
+	IndexBlocksTouched int64     `json:"index_blocks_touched"`
+	IndexPagesAdded    int64     `json:"index_pages_added"`
+	BufferHitRatio     float64   `json:"buffer_hit_ratio"`
+	Duration           float64   `json:"duration"`
+	Timestamp          time.Time `json:"timestamp"`
+}
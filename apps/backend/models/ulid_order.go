@@ -0,0 +1,36 @@
+package models
+
+import (
+	"github.com/jinzhu/copier"
+)
+
+// OrderUlid is an order placed by a UserUlid.
+type OrderUlid struct {
+	ID     string `gorm:"column:id;type:varchar(26);primaryKey" json:"id"`
+	UserID string `gorm:"column:user_id;type:varchar(26);not null;index:idx_orders_ulid_user_id" json:"user_id"`
+	*OrderBase
+	User *UserUlid `gorm:"foreignKey:UserID;references:ID;constraint:OnDelete:CASCADE" json:"-"`
+}
+
+// TableName sets the table name for OrderUlid to "orders_ulid".
+func (OrderUlid) TableName() string {
+	return "orders_ulid"
+}
+
+func InputToOrderUlid(orderCreate OrderInput, userId string) *OrderUlid {
+	var order OrderUlid
+	copier.Copy(&order, &orderCreate)
+	if orderCreate.Id != nil {
+		order.ID = *orderCreate.Id
+	} else {
+		order.ID = ""
+	}
+	order.UserID = userId
+	return &order
+}
+
+func (order *OrderUlid) OrderUlidToDTO() *OrderDTO {
+	var orderDTO OrderDTO
+	copier.Copy(&orderDTO, &order)
+	return &orderDTO
+}
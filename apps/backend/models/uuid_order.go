@@ -0,0 +1,36 @@
+package models
+
+import (
+	"github.com/jinzhu/copier"
+)
+
+// OrderUUID is an order placed by a UserUUID.
+type OrderUUID struct {
+	ID     string `gorm:"column:id;type:varchar(36);primaryKey" json:"id"`
+	UserID string `gorm:"column:user_id;type:varchar(36);not null;index:idx_orders_uuid_user_id" json:"user_id"`
+	*OrderBase
+	User *UserUUID `gorm:"foreignKey:UserID;references:ID;constraint:OnDelete:CASCADE" json:"-"`
+}
+
+// TableName sets the table name for OrderUUID to "orders_uuid".
+func (OrderUUID) TableName() string {
+	return "orders_uuid"
+}
+
+func InputToOrderUUID(orderCreate OrderInput, userId string) *OrderUUID {
+	var order OrderUUID
+	copier.Copy(&order, &orderCreate)
+	if orderCreate.Id != nil {
+		order.ID = *orderCreate.Id
+	} else {
+		order.ID = ""
+	}
+	order.UserID = userId
+	return &order
+}
+
+func (order *OrderUUID) OrderUuidToDTO() *OrderDTO {
+	var orderDTO OrderDTO
+	copier.Copy(&orderDTO, &order)
+	return &orderDTO
+}
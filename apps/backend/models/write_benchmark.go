@@ -0,0 +1,40 @@
+package models
+
+import (
+	"time"
+)
+
+// WriteBenchmark stores the storage-level cost of one controlled insert batch
+// for a single ID type, as measured by the write amplification benchmark.
+type WriteBenchmark struct {
+	ID uint `gorm:"primaryKey"`
+
+	// Run Information
+	RunID   string `gorm:"type:varchar(40);not null;index:idx_write_run"`
+	IDType  string `gorm:"type:varchar(20);not null;index:idx_write_id_type"`
+	Table   string `gorm:"column:table_name;type:varchar(63);not null"`
+	Records int64  `gorm:"not null"`
+	Seed    int64  // Seed of the generated users, to replay the run
+
+	// WAL Metrics (deltas across the batch)
+	WalBytes   int64 `gorm:"not null"`
+	WalRecords int64 `gorm:"not null"`
+	WalFpi     int64 `gorm:"not null"` // Full page images, a proxy for page splits
+
+	// Index Metrics (deltas across the batch)
+	IndexPagesBefore int64 `gorm:"not null"`
+	IndexPagesAfter  int64 `gorm:"not null"`
+	IndexBlocksRead  int64 `gorm:"not null"`
+	IndexBlocksHit   int64 `gorm:"not null"`
+
+	// Heap Metrics (deltas across the batch)
+	HeapBlocksRead int64 `gorm:"not null"`
+	HeapBlocksHit  int64 `gorm:"not null"`
+
+	Duration  float64   `gorm:"not null"` // Batch duration in milliseconds
+	Timestamp time.Time `gorm:"not null;index:idx_write_timestamp"`
+}
+
+func (WriteBenchmark) TableName() string {
+	return "write_benchmarks"
+}
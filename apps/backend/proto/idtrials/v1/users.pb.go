@@ -0,0 +1,919 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: idtrials/v1/users.proto
+
+package idtrialsv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// UserFields are the columns the users tables of every ID type share.
+type UserFields struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	UserName   string                 `protobuf:"bytes,1,opt,name=user_name,json=userName,proto3" json:"user_name,omitempty"`
+	FirstName  string                 `protobuf:"bytes,2,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`
+	LastName   string                 `protobuf:"bytes,3,opt,name=last_name,json=lastName,proto3" json:"last_name,omitempty"`
+	Email      string                 `protobuf:"bytes,4,opt,name=email,proto3" json:"email,omitempty"`
+	Department *string                `protobuf:"bytes,5,opt,name=department,proto3,oneof" json:"department,omitempty"`
+	// Version increases on every write. Sent to UpdateUser, the write only
+	// applies while the stored version still matches.
+	Version       int64 `protobuf:"varint,6,opt,name=version,proto3" json:"version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserFields) Reset() {
+	*x = UserFields{}
+	mi := &file_idtrials_v1_users_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserFields) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserFields) ProtoMessage() {}
+
+func (x *UserFields) ProtoReflect() protoreflect.Message {
+	mi := &file_idtrials_v1_users_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserFields.ProtoReflect.Descriptor instead.
+func (*UserFields) Descriptor() ([]byte, []int) {
+	return file_idtrials_v1_users_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *UserFields) GetUserName() string {
+	if x != nil {
+		return x.UserName
+	}
+	return ""
+}
+
+func (x *UserFields) GetFirstName() string {
+	if x != nil {
+		return x.FirstName
+	}
+	return ""
+}
+
+func (x *UserFields) GetLastName() string {
+	if x != nil {
+		return x.LastName
+	}
+	return ""
+}
+
+func (x *UserFields) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *UserFields) GetDepartment() string {
+	if x != nil && x.Department != nil {
+		return *x.Department
+	}
+	return ""
+}
+
+func (x *UserFields) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+// ListUsersRequest pages through the users matching search.
+type ListUsersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Search        string                 `protobuf:"bytes,1,opt,name=search,proto3" json:"search,omitempty"`
+	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`   // 1 when unset
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"` // 25 when unset
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUsersRequest) Reset() {
+	*x = ListUsersRequest{}
+	mi := &file_idtrials_v1_users_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUsersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUsersRequest) ProtoMessage() {}
+
+func (x *ListUsersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_idtrials_v1_users_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUsersRequest.ProtoReflect.Descriptor instead.
+func (*ListUsersRequest) Descriptor() ([]byte, []int) {
+	return file_idtrials_v1_users_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ListUsersRequest) GetSearch() string {
+	if x != nil {
+		return x.Search
+	}
+	return ""
+}
+
+func (x *ListUsersRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListUsersRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// Page is where a ListUsers response sits among the matching users.
+type Page struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	PageCount     int32                  `protobuf:"varint,2,opt,name=page_count,json=pageCount,proto3" json:"page_count,omitempty"`
+	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Page) Reset() {
+	*x = Page{}
+	mi := &file_idtrials_v1_users_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Page) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Page) ProtoMessage() {}
+
+func (x *Page) ProtoReflect() protoreflect.Message {
+	mi := &file_idtrials_v1_users_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Page.ProtoReflect.Descriptor instead.
+func (*Page) Descriptor() ([]byte, []int) {
+	return file_idtrials_v1_users_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Page) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *Page) GetPageCount() int32 {
+	if x != nil {
+		return x.PageCount
+	}
+	return 0
+}
+
+func (x *Page) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+// BytesUser is a user whose ID is binary: 16 bytes for ULID and UUID, 20 for
+// KSUID.
+type BytesUser struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            []byte                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Fields        *UserFields            `protobuf:"bytes,2,opt,name=fields,proto3" json:"fields,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BytesUser) Reset() {
+	*x = BytesUser{}
+	mi := &file_idtrials_v1_users_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BytesUser) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BytesUser) ProtoMessage() {}
+
+func (x *BytesUser) ProtoReflect() protoreflect.Message {
+	mi := &file_idtrials_v1_users_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BytesUser.ProtoReflect.Descriptor instead.
+func (*BytesUser) Descriptor() ([]byte, []int) {
+	return file_idtrials_v1_users_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *BytesUser) GetId() []byte {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+func (x *BytesUser) GetFields() *UserFields {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+type BytesUserID struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            []byte                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BytesUserID) Reset() {
+	*x = BytesUserID{}
+	mi := &file_idtrials_v1_users_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BytesUserID) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BytesUserID) ProtoMessage() {}
+
+func (x *BytesUserID) ProtoReflect() protoreflect.Message {
+	mi := &file_idtrials_v1_users_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BytesUserID.ProtoReflect.Descriptor instead.
+func (*BytesUserID) Descriptor() ([]byte, []int) {
+	return file_idtrials_v1_users_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *BytesUserID) GetId() []byte {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+type BytesUsers struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Users         []*BytesUser           `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	Page          *Page                  `protobuf:"bytes,2,opt,name=page,proto3" json:"page,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BytesUsers) Reset() {
+	*x = BytesUsers{}
+	mi := &file_idtrials_v1_users_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BytesUsers) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BytesUsers) ProtoMessage() {}
+
+func (x *BytesUsers) ProtoReflect() protoreflect.Message {
+	mi := &file_idtrials_v1_users_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BytesUsers.ProtoReflect.Descriptor instead.
+func (*BytesUsers) Descriptor() ([]byte, []int) {
+	return file_idtrials_v1_users_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *BytesUsers) GetUsers() []*BytesUser {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+func (x *BytesUsers) GetPage() *Page {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+// StringUser is a user whose ID is text, as CUIDs and NanoIDs are.
+type StringUser struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Fields        *UserFields            `protobuf:"bytes,2,opt,name=fields,proto3" json:"fields,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StringUser) Reset() {
+	*x = StringUser{}
+	mi := &file_idtrials_v1_users_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StringUser) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StringUser) ProtoMessage() {}
+
+func (x *StringUser) ProtoReflect() protoreflect.Message {
+	mi := &file_idtrials_v1_users_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StringUser.ProtoReflect.Descriptor instead.
+func (*StringUser) Descriptor() ([]byte, []int) {
+	return file_idtrials_v1_users_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *StringUser) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *StringUser) GetFields() *UserFields {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+type StringUserID struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StringUserID) Reset() {
+	*x = StringUserID{}
+	mi := &file_idtrials_v1_users_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StringUserID) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StringUserID) ProtoMessage() {}
+
+func (x *StringUserID) ProtoReflect() protoreflect.Message {
+	mi := &file_idtrials_v1_users_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StringUserID.ProtoReflect.Descriptor instead.
+func (*StringUserID) Descriptor() ([]byte, []int) {
+	return file_idtrials_v1_users_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *StringUserID) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type StringUsers struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Users         []*StringUser          `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	Page          *Page                  `protobuf:"bytes,2,opt,name=page,proto3" json:"page,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StringUsers) Reset() {
+	*x = StringUsers{}
+	mi := &file_idtrials_v1_users_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StringUsers) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StringUsers) ProtoMessage() {}
+
+func (x *StringUsers) ProtoReflect() protoreflect.Message {
+	mi := &file_idtrials_v1_users_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StringUsers.ProtoReflect.Descriptor instead.
+func (*StringUsers) Descriptor() ([]byte, []int) {
+	return file_idtrials_v1_users_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *StringUsers) GetUsers() []*StringUser {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+func (x *StringUsers) GetPage() *Page {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+// Int64User is a user whose ID is a number, as snowflakes are.
+type Int64User struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Fields        *UserFields            `protobuf:"bytes,2,opt,name=fields,proto3" json:"fields,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Int64User) Reset() {
+	*x = Int64User{}
+	mi := &file_idtrials_v1_users_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Int64User) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Int64User) ProtoMessage() {}
+
+func (x *Int64User) ProtoReflect() protoreflect.Message {
+	mi := &file_idtrials_v1_users_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Int64User.ProtoReflect.Descriptor instead.
+func (*Int64User) Descriptor() ([]byte, []int) {
+	return file_idtrials_v1_users_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *Int64User) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Int64User) GetFields() *UserFields {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+type Int64UserID struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Int64UserID) Reset() {
+	*x = Int64UserID{}
+	mi := &file_idtrials_v1_users_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Int64UserID) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Int64UserID) ProtoMessage() {}
+
+func (x *Int64UserID) ProtoReflect() protoreflect.Message {
+	mi := &file_idtrials_v1_users_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Int64UserID.ProtoReflect.Descriptor instead.
+func (*Int64UserID) Descriptor() ([]byte, []int) {
+	return file_idtrials_v1_users_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *Int64UserID) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type Int64Users struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Users         []*Int64User           `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	Page          *Page                  `protobuf:"bytes,2,opt,name=page,proto3" json:"page,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Int64Users) Reset() {
+	*x = Int64Users{}
+	mi := &file_idtrials_v1_users_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Int64Users) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Int64Users) ProtoMessage() {}
+
+func (x *Int64Users) ProtoReflect() protoreflect.Message {
+	mi := &file_idtrials_v1_users_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Int64Users.ProtoReflect.Descriptor instead.
+func (*Int64Users) Descriptor() ([]byte, []int) {
+	return file_idtrials_v1_users_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *Int64Users) GetUsers() []*Int64User {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+func (x *Int64Users) GetPage() *Page {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+var File_idtrials_v1_users_proto protoreflect.FileDescriptor
+
+const file_idtrials_v1_users_proto_rawDesc = "" +
+	"\n" +
+	"\x17idtrials/v1/users.proto\x12\vidtrials.v1\x1a\x1bgoogle/protobuf/empty.proto\"\xc9\x01\n" +
+	"\n" +
+	"UserFields\x12\x1b\n" +
+	"\tuser_name\x18\x01 \x01(\tR\buserName\x12\x1d\n" +
+	"\n" +
+	"first_name\x18\x02 \x01(\tR\tfirstName\x12\x1b\n" +
+	"\tlast_name\x18\x03 \x01(\tR\blastName\x12\x14\n" +
+	"\x05email\x18\x04 \x01(\tR\x05email\x12#\n" +
+	"\n" +
+	"department\x18\x05 \x01(\tH\x00R\n" +
+	"department\x88\x01\x01\x12\x18\n" +
+	"\aversion\x18\x06 \x01(\x03R\aversionB\r\n" +
+	"\v_department\"T\n" +
+	"\x10ListUsersRequest\x12\x16\n" +
+	"\x06search\x18\x01 \x01(\tR\x06search\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\"V\n" +
+	"\x04Page\x12\x12\n" +
+	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x1d\n" +
+	"\n" +
+	"page_count\x18\x02 \x01(\x05R\tpageCount\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\"L\n" +
+	"\tBytesUser\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\fR\x02id\x12/\n" +
+	"\x06fields\x18\x02 \x01(\v2\x17.idtrials.v1.UserFieldsR\x06fields\"\x1d\n" +
+	"\vBytesUserID\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\fR\x02id\"a\n" +
+	"\n" +
+	"BytesUsers\x12,\n" +
+	"\x05users\x18\x01 \x03(\v2\x16.idtrials.v1.BytesUserR\x05users\x12%\n" +
+	"\x04page\x18\x02 \x01(\v2\x11.idtrials.v1.PageR\x04page\"M\n" +
+	"\n" +
+	"StringUser\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12/\n" +
+	"\x06fields\x18\x02 \x01(\v2\x17.idtrials.v1.UserFieldsR\x06fields\"\x1e\n" +
+	"\fStringUserID\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"c\n" +
+	"\vStringUsers\x12-\n" +
+	"\x05users\x18\x01 \x03(\v2\x17.idtrials.v1.StringUserR\x05users\x12%\n" +
+	"\x04page\x18\x02 \x01(\v2\x11.idtrials.v1.PageR\x04page\"L\n" +
+	"\tInt64User\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12/\n" +
+	"\x06fields\x18\x02 \x01(\v2\x17.idtrials.v1.UserFieldsR\x06fields\"\x1d\n" +
+	"\vInt64UserID\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"a\n" +
+	"\n" +
+	"Int64Users\x12,\n" +
+	"\x05users\x18\x01 \x03(\v2\x16.idtrials.v1.Int64UserR\x05users\x12%\n" +
+	"\x04page\x18\x02 \x01(\v2\x11.idtrials.v1.PageR\x04page2\xca\x02\n" +
+	"\tUlidUsers\x12;\n" +
+	"\aGetUser\x12\x18.idtrials.v1.BytesUserID\x1a\x16.idtrials.v1.BytesUser\x12C\n" +
+	"\tListUsers\x12\x1d.idtrials.v1.ListUsersRequest\x1a\x17.idtrials.v1.BytesUsers\x12=\n" +
+	"\n" +
+	"CreateUser\x12\x17.idtrials.v1.UserFields\x1a\x16.idtrials.v1.BytesUser\x12<\n" +
+	"\n" +
+	"UpdateUser\x12\x16.idtrials.v1.BytesUser\x1a\x16.idtrials.v1.BytesUser\x12>\n" +
+	"\n" +
+	"DeleteUser\x12\x18.idtrials.v1.BytesUserID\x1a\x16.google.protobuf.Empty2\xca\x02\n" +
+	"\tUuidUsers\x12;\n" +
+	"\aGetUser\x12\x18.idtrials.v1.BytesUserID\x1a\x16.idtrials.v1.BytesUser\x12C\n" +
+	"\tListUsers\x12\x1d.idtrials.v1.ListUsersRequest\x1a\x17.idtrials.v1.BytesUsers\x12=\n" +
+	"\n" +
+	"CreateUser\x12\x17.idtrials.v1.UserFields\x1a\x16.idtrials.v1.BytesUser\x12<\n" +
+	"\n" +
+	"UpdateUser\x12\x16.idtrials.v1.BytesUser\x1a\x16.idtrials.v1.BytesUser\x12>\n" +
+	"\n" +
+	"DeleteUser\x12\x18.idtrials.v1.BytesUserID\x1a\x16.google.protobuf.Empty2\xcb\x02\n" +
+	"\n" +
+	"KsuidUsers\x12;\n" +
+	"\aGetUser\x12\x18.idtrials.v1.BytesUserID\x1a\x16.idtrials.v1.BytesUser\x12C\n" +
+	"\tListUsers\x12\x1d.idtrials.v1.ListUsersRequest\x1a\x17.idtrials.v1.BytesUsers\x12=\n" +
+	"\n" +
+	"CreateUser\x12\x17.idtrials.v1.UserFields\x1a\x16.idtrials.v1.BytesUser\x12<\n" +
+	"\n" +
+	"UpdateUser\x12\x16.idtrials.v1.BytesUser\x1a\x16.idtrials.v1.BytesUser\x12>\n" +
+	"\n" +
+	"DeleteUser\x12\x18.idtrials.v1.BytesUserID\x1a\x16.google.protobuf.Empty2\xd1\x02\n" +
+	"\tCuidUsers\x12=\n" +
+	"\aGetUser\x12\x19.idtrials.v1.StringUserID\x1a\x17.idtrials.v1.StringUser\x12D\n" +
+	"\tListUsers\x12\x1d.idtrials.v1.ListUsersRequest\x1a\x18.idtrials.v1.StringUsers\x12>\n" +
+	"\n" +
+	"CreateUser\x12\x17.idtrials.v1.UserFields\x1a\x17.idtrials.v1.StringUser\x12>\n" +
+	"\n" +
+	"UpdateUser\x12\x17.idtrials.v1.StringUser\x1a\x17.idtrials.v1.StringUser\x12?\n" +
+	"\n" +
+	"DeleteUser\x12\x19.idtrials.v1.StringUserID\x1a\x16.google.protobuf.Empty2\xd3\x02\n" +
+	"\vNanoidUsers\x12=\n" +
+	"\aGetUser\x12\x19.idtrials.v1.StringUserID\x1a\x17.idtrials.v1.StringUser\x12D\n" +
+	"\tListUsers\x12\x1d.idtrials.v1.ListUsersRequest\x1a\x18.idtrials.v1.StringUsers\x12>\n" +
+	"\n" +
+	"CreateUser\x12\x17.idtrials.v1.UserFields\x1a\x17.idtrials.v1.StringUser\x12>\n" +
+	"\n" +
+	"UpdateUser\x12\x17.idtrials.v1.StringUser\x1a\x17.idtrials.v1.StringUser\x12?\n" +
+	"\n" +
+	"DeleteUser\x12\x19.idtrials.v1.StringUserID\x1a\x16.google.protobuf.Empty2\xcf\x02\n" +
+	"\x0eSnowflakeUsers\x12;\n" +
+	"\aGetUser\x12\x18.idtrials.v1.Int64UserID\x1a\x16.idtrials.v1.Int64User\x12C\n" +
+	"\tListUsers\x12\x1d.idtrials.v1.ListUsersRequest\x1a\x17.idtrials.v1.Int64Users\x12=\n" +
+	"\n" +
+	"CreateUser\x12\x17.idtrials.v1.UserFields\x1a\x16.idtrials.v1.Int64User\x12<\n" +
+	"\n" +
+	"UpdateUser\x12\x16.idtrials.v1.Int64User\x1a\x16.idtrials.v1.Int64User\x12>\n" +
+	"\n" +
+	"DeleteUser\x12\x18.idtrials.v1.Int64UserID\x1a\x16.google.protobuf.EmptyBPZNgithub.com/theCompanyDream/id-trials/apps/backend/proto/idtrials/v1;idtrialsv1b\x06proto3"
+
+var (
+	file_idtrials_v1_users_proto_rawDescOnce sync.Once
+	file_idtrials_v1_users_proto_rawDescData []byte
+)
+
+func file_idtrials_v1_users_proto_rawDescGZIP() []byte {
+	file_idtrials_v1_users_proto_rawDescOnce.Do(func() {
+		file_idtrials_v1_users_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_idtrials_v1_users_proto_rawDesc), len(file_idtrials_v1_users_proto_rawDesc)))
+	})
+	return file_idtrials_v1_users_proto_rawDescData
+}
+
+var file_idtrials_v1_users_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
+var file_idtrials_v1_users_proto_goTypes = []any{
+	(*UserFields)(nil),       // 0: idtrials.v1.UserFields
+	(*ListUsersRequest)(nil), // 1: idtrials.v1.ListUsersRequest
+	(*Page)(nil),             // 2: idtrials.v1.Page
+	(*BytesUser)(nil),        // 3: idtrials.v1.BytesUser
+	(*BytesUserID)(nil),      // 4: idtrials.v1.BytesUserID
+	(*BytesUsers)(nil),       // 5: idtrials.v1.BytesUsers
+	(*StringUser)(nil),       // 6: idtrials.v1.StringUser
+	(*StringUserID)(nil),     // 7: idtrials.v1.StringUserID
+	(*StringUsers)(nil),      // 8: idtrials.v1.StringUsers
+	(*Int64User)(nil),        // 9: idtrials.v1.Int64User
+	(*Int64UserID)(nil),      // 10: idtrials.v1.Int64UserID
+	(*Int64Users)(nil),       // 11: idtrials.v1.Int64Users
+	(*emptypb.Empty)(nil),    // 12: google.protobuf.Empty
+}
+var file_idtrials_v1_users_proto_depIdxs = []int32{
+	0,  // 0: idtrials.v1.BytesUser.fields:type_name -> idtrials.v1.UserFields
+	3,  // 1: idtrials.v1.BytesUsers.users:type_name -> idtrials.v1.BytesUser
+	2,  // 2: idtrials.v1.BytesUsers.page:type_name -> idtrials.v1.Page
+	0,  // 3: idtrials.v1.StringUser.fields:type_name -> idtrials.v1.UserFields
+	6,  // 4: idtrials.v1.StringUsers.users:type_name -> idtrials.v1.StringUser
+	2,  // 5: idtrials.v1.StringUsers.page:type_name -> idtrials.v1.Page
+	0,  // 6: idtrials.v1.Int64User.fields:type_name -> idtrials.v1.UserFields
+	9,  // 7: idtrials.v1.Int64Users.users:type_name -> idtrials.v1.Int64User
+	2,  // 8: idtrials.v1.Int64Users.page:type_name -> idtrials.v1.Page
+	4,  // 9: idtrials.v1.UlidUsers.GetUser:input_type -> idtrials.v1.BytesUserID
+	1,  // 10: idtrials.v1.UlidUsers.ListUsers:input_type -> idtrials.v1.ListUsersRequest
+	0,  // 11: idtrials.v1.UlidUsers.CreateUser:input_type -> idtrials.v1.UserFields
+	3,  // 12: idtrials.v1.UlidUsers.UpdateUser:input_type -> idtrials.v1.BytesUser
+	4,  // 13: idtrials.v1.UlidUsers.DeleteUser:input_type -> idtrials.v1.BytesUserID
+	4,  // 14: idtrials.v1.UuidUsers.GetUser:input_type -> idtrials.v1.BytesUserID
+	1,  // 15: idtrials.v1.UuidUsers.ListUsers:input_type -> idtrials.v1.ListUsersRequest
+	0,  // 16: idtrials.v1.UuidUsers.CreateUser:input_type -> idtrials.v1.UserFields
+	3,  // 17: idtrials.v1.UuidUsers.UpdateUser:input_type -> idtrials.v1.BytesUser
+	4,  // 18: idtrials.v1.UuidUsers.DeleteUser:input_type -> idtrials.v1.BytesUserID
+	4,  // 19: idtrials.v1.KsuidUsers.GetUser:input_type -> idtrials.v1.BytesUserID
+	1,  // 20: idtrials.v1.KsuidUsers.ListUsers:input_type -> idtrials.v1.ListUsersRequest
+	0,  // 21: idtrials.v1.KsuidUsers.CreateUser:input_type -> idtrials.v1.UserFields
+	3,  // 22: idtrials.v1.KsuidUsers.UpdateUser:input_type -> idtrials.v1.BytesUser
+	4,  // 23: idtrials.v1.KsuidUsers.DeleteUser:input_type -> idtrials.v1.BytesUserID
+	7,  // 24: idtrials.v1.CuidUsers.GetUser:input_type -> idtrials.v1.StringUserID
+	1,  // 25: idtrials.v1.CuidUsers.ListUsers:input_type -> idtrials.v1.ListUsersRequest
+	0,  // 26: idtrials.v1.CuidUsers.CreateUser:input_type -> idtrials.v1.UserFields
+	6,  // 27: idtrials.v1.CuidUsers.UpdateUser:input_type -> idtrials.v1.StringUser
+	7,  // 28: idtrials.v1.CuidUsers.DeleteUser:input_type -> idtrials.v1.StringUserID
+	7,  // 29: idtrials.v1.NanoidUsers.GetUser:input_type -> idtrials.v1.StringUserID
+	1,  // 30: idtrials.v1.NanoidUsers.ListUsers:input_type -> idtrials.v1.ListUsersRequest
+	0,  // 31: idtrials.v1.NanoidUsers.CreateUser:input_type -> idtrials.v1.UserFields
+	6,  // 32: idtrials.v1.NanoidUsers.UpdateUser:input_type -> idtrials.v1.StringUser
+	7,  // 33: idtrials.v1.NanoidUsers.DeleteUser:input_type -> idtrials.v1.StringUserID
+	10, // 34: idtrials.v1.SnowflakeUsers.GetUser:input_type -> idtrials.v1.Int64UserID
+	1,  // 35: idtrials.v1.SnowflakeUsers.ListUsers:input_type -> idtrials.v1.ListUsersRequest
+	0,  // 36: idtrials.v1.SnowflakeUsers.CreateUser:input_type -> idtrials.v1.UserFields
+	9,  // 37: idtrials.v1.SnowflakeUsers.UpdateUser:input_type -> idtrials.v1.Int64User
+	10, // 38: idtrials.v1.SnowflakeUsers.DeleteUser:input_type -> idtrials.v1.Int64UserID
+	3,  // 39: idtrials.v1.UlidUsers.GetUser:output_type -> idtrials.v1.BytesUser
+	5,  // 40: idtrials.v1.UlidUsers.ListUsers:output_type -> idtrials.v1.BytesUsers
+	3,  // 41: idtrials.v1.UlidUsers.CreateUser:output_type -> idtrials.v1.BytesUser
+	3,  // 42: idtrials.v1.UlidUsers.UpdateUser:output_type -> idtrials.v1.BytesUser
+	12, // 43: idtrials.v1.UlidUsers.DeleteUser:output_type -> google.protobuf.Empty
+	3,  // 44: idtrials.v1.UuidUsers.GetUser:output_type -> idtrials.v1.BytesUser
+	5,  // 45: idtrials.v1.UuidUsers.ListUsers:output_type -> idtrials.v1.BytesUsers
+	3,  // 46: idtrials.v1.UuidUsers.CreateUser:output_type -> idtrials.v1.BytesUser
+	3,  // 47: idtrials.v1.UuidUsers.UpdateUser:output_type -> idtrials.v1.BytesUser
+	12, // 48: idtrials.v1.UuidUsers.DeleteUser:output_type -> google.protobuf.Empty
+	3,  // 49: idtrials.v1.KsuidUsers.GetUser:output_type -> idtrials.v1.BytesUser
+	5,  // 50: idtrials.v1.KsuidUsers.ListUsers:output_type -> idtrials.v1.BytesUsers
+	3,  // 51: idtrials.v1.KsuidUsers.CreateUser:output_type -> idtrials.v1.BytesUser
+	3,  // 52: idtrials.v1.KsuidUsers.UpdateUser:output_type -> idtrials.v1.BytesUser
+	12, // 53: idtrials.v1.KsuidUsers.DeleteUser:output_type -> google.protobuf.Empty
+	6,  // 54: idtrials.v1.CuidUsers.GetUser:output_type -> idtrials.v1.StringUser
+	8,  // 55: idtrials.v1.CuidUsers.ListUsers:output_type -> idtrials.v1.StringUsers
+	6,  // 56: idtrials.v1.CuidUsers.CreateUser:output_type -> idtrials.v1.StringUser
+	6,  // 57: idtrials.v1.CuidUsers.UpdateUser:output_type -> idtrials.v1.StringUser
+	12, // 58: idtrials.v1.CuidUsers.DeleteUser:output_type -> google.protobuf.Empty
+	6,  // 59: idtrials.v1.NanoidUsers.GetUser:output_type -> idtrials.v1.StringUser
+	8,  // 60: idtrials.v1.NanoidUsers.ListUsers:output_type -> idtrials.v1.StringUsers
+	6,  // 61: idtrials.v1.NanoidUsers.CreateUser:output_type -> idtrials.v1.StringUser
+	6,  // 62: idtrials.v1.NanoidUsers.UpdateUser:output_type -> idtrials.v1.StringUser
+	12, // 63: idtrials.v1.NanoidUsers.DeleteUser:output_type -> google.protobuf.Empty
+	9,  // 64: idtrials.v1.SnowflakeUsers.GetUser:output_type -> idtrials.v1.Int64User
+	11, // 65: idtrials.v1.SnowflakeUsers.ListUsers:output_type -> idtrials.v1.Int64Users
+	9,  // 66: idtrials.v1.SnowflakeUsers.CreateUser:output_type -> idtrials.v1.Int64User
+	9,  // 67: idtrials.v1.SnowflakeUsers.UpdateUser:output_type -> idtrials.v1.Int64User
+	12, // 68: idtrials.v1.SnowflakeUsers.DeleteUser:output_type -> google.protobuf.Empty
+	39, // [39:69] is the sub-list for method output_type
+	9,  // [9:39] is the sub-list for method input_type
+	9,  // [9:9] is the sub-list for extension type_name
+	9,  // [9:9] is the sub-list for extension extendee
+	0,  // [0:9] is the sub-list for field type_name
+}
+
+func init() { file_idtrials_v1_users_proto_init() }
+func file_idtrials_v1_users_proto_init() {
+	if File_idtrials_v1_users_proto != nil {
+		return
+	}
+	file_idtrials_v1_users_proto_msgTypes[0].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_idtrials_v1_users_proto_rawDesc), len(file_idtrials_v1_users_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   12,
+			NumExtensions: 0,
+			NumServices:   6,
+		},
+		GoTypes:           file_idtrials_v1_users_proto_goTypes,
+		DependencyIndexes: file_idtrials_v1_users_proto_depIdxs,
+		MessageInfos:      file_idtrials_v1_users_proto_msgTypes,
+	}.Build()
+	File_idtrials_v1_users_proto = out.File
+	file_idtrials_v1_users_proto_goTypes = nil
+	file_idtrials_v1_users_proto_depIdxs = nil
+}
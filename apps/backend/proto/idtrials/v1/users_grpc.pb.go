@@ -0,0 +1,1532 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: idtrials/v1/users.proto
+
+package idtrialsv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	UlidUsers_GetUser_FullMethodName    = "/idtrials.v1.UlidUsers/GetUser"
+	UlidUsers_ListUsers_FullMethodName  = "/idtrials.v1.UlidUsers/ListUsers"
+	UlidUsers_CreateUser_FullMethodName = "/idtrials.v1.UlidUsers/CreateUser"
+	UlidUsers_UpdateUser_FullMethodName = "/idtrials.v1.UlidUsers/UpdateUser"
+	UlidUsers_DeleteUser_FullMethodName = "/idtrials.v1.UlidUsers/DeleteUser"
+)
+
+// UlidUsersClient is the client API for UlidUsers service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type UlidUsersClient interface {
+	GetUser(ctx context.Context, in *BytesUserID, opts ...grpc.CallOption) (*BytesUser, error)
+	ListUsers(ctx context.Context, in *ListUsersRequest, opts ...grpc.CallOption) (*BytesUsers, error)
+	CreateUser(ctx context.Context, in *UserFields, opts ...grpc.CallOption) (*BytesUser, error)
+	UpdateUser(ctx context.Context, in *BytesUser, opts ...grpc.CallOption) (*BytesUser, error)
+	DeleteUser(ctx context.Context, in *BytesUserID, opts ...grpc.CallOption) (*emptypb.Empty, error)
+}
+
+type ulidUsersClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewUlidUsersClient(cc grpc.ClientConnInterface) UlidUsersClient {
+	return &ulidUsersClient{cc}
+}
+
+func (c *ulidUsersClient) GetUser(ctx context.Context, in *BytesUserID, opts ...grpc.CallOption) (*BytesUser, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BytesUser)
+	err := c.cc.Invoke(ctx, UlidUsers_GetUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ulidUsersClient) ListUsers(ctx context.Context, in *ListUsersRequest, opts ...grpc.CallOption) (*BytesUsers, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BytesUsers)
+	err := c.cc.Invoke(ctx, UlidUsers_ListUsers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ulidUsersClient) CreateUser(ctx context.Context, in *UserFields, opts ...grpc.CallOption) (*BytesUser, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BytesUser)
+	err := c.cc.Invoke(ctx, UlidUsers_CreateUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ulidUsersClient) UpdateUser(ctx context.Context, in *BytesUser, opts ...grpc.CallOption) (*BytesUser, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BytesUser)
+	err := c.cc.Invoke(ctx, UlidUsers_UpdateUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ulidUsersClient) DeleteUser(ctx context.Context, in *BytesUserID, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, UlidUsers_DeleteUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// UlidUsersServer is the server API for UlidUsers service.
+// All implementations should embed UnimplementedUlidUsersServer
+// for forward compatibility.
+type UlidUsersServer interface {
+	GetUser(context.Context, *BytesUserID) (*BytesUser, error)
+	ListUsers(context.Context, *ListUsersRequest) (*BytesUsers, error)
+	CreateUser(context.Context, *UserFields) (*BytesUser, error)
+	UpdateUser(context.Context, *BytesUser) (*BytesUser, error)
+	DeleteUser(context.Context, *BytesUserID) (*emptypb.Empty, error)
+}
+
+// UnimplementedUlidUsersServer should be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedUlidUsersServer struct{}
+
+func (UnimplementedUlidUsersServer) GetUser(context.Context, *BytesUserID) (*BytesUser, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUser not implemented")
+}
+func (UnimplementedUlidUsersServer) ListUsers(context.Context, *ListUsersRequest) (*BytesUsers, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListUsers not implemented")
+}
+func (UnimplementedUlidUsersServer) CreateUser(context.Context, *UserFields) (*BytesUser, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateUser not implemented")
+}
+func (UnimplementedUlidUsersServer) UpdateUser(context.Context, *BytesUser) (*BytesUser, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateUser not implemented")
+}
+func (UnimplementedUlidUsersServer) DeleteUser(context.Context, *BytesUserID) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteUser not implemented")
+}
+func (UnimplementedUlidUsersServer) testEmbeddedByValue() {}
+
+// UnsafeUlidUsersServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to UlidUsersServer will
+// result in compilation errors.
+type UnsafeUlidUsersServer interface {
+	mustEmbedUnimplementedUlidUsersServer()
+}
+
+func RegisterUlidUsersServer(s grpc.ServiceRegistrar, srv UlidUsersServer) {
+	// If the following call pancis, it indicates UnimplementedUlidUsersServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&UlidUsers_ServiceDesc, srv)
+}
+
+func _UlidUsers_GetUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BytesUserID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UlidUsersServer).GetUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UlidUsers_GetUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UlidUsersServer).GetUser(ctx, req.(*BytesUserID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UlidUsers_ListUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUsersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UlidUsersServer).ListUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UlidUsers_ListUsers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UlidUsersServer).ListUsers(ctx, req.(*ListUsersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UlidUsers_CreateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserFields)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UlidUsersServer).CreateUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UlidUsers_CreateUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UlidUsersServer).CreateUser(ctx, req.(*UserFields))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UlidUsers_UpdateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BytesUser)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UlidUsersServer).UpdateUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UlidUsers_UpdateUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UlidUsersServer).UpdateUser(ctx, req.(*BytesUser))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UlidUsers_DeleteUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BytesUserID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UlidUsersServer).DeleteUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UlidUsers_DeleteUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UlidUsersServer).DeleteUser(ctx, req.(*BytesUserID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// UlidUsers_ServiceDesc is the grpc.ServiceDesc for UlidUsers service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var UlidUsers_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "idtrials.v1.UlidUsers",
+	HandlerType: (*UlidUsersServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetUser",
+			Handler:    _UlidUsers_GetUser_Handler,
+		},
+		{
+			MethodName: "ListUsers",
+			Handler:    _UlidUsers_ListUsers_Handler,
+		},
+		{
+			MethodName: "CreateUser",
+			Handler:    _UlidUsers_CreateUser_Handler,
+		},
+		{
+			MethodName: "UpdateUser",
+			Handler:    _UlidUsers_UpdateUser_Handler,
+		},
+		{
+			MethodName: "DeleteUser",
+			Handler:    _UlidUsers_DeleteUser_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "idtrials/v1/users.proto",
+}
+
+const (
+	UuidUsers_GetUser_FullMethodName    = "/idtrials.v1.UuidUsers/GetUser"
+	UuidUsers_ListUsers_FullMethodName  = "/idtrials.v1.UuidUsers/ListUsers"
+	UuidUsers_CreateUser_FullMethodName = "/idtrials.v1.UuidUsers/CreateUser"
+	UuidUsers_UpdateUser_FullMethodName = "/idtrials.v1.UuidUsers/UpdateUser"
+	UuidUsers_DeleteUser_FullMethodName = "/idtrials.v1.UuidUsers/DeleteUser"
+)
+
+// UuidUsersClient is the client API for UuidUsers service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type UuidUsersClient interface {
+	GetUser(ctx context.Context, in *BytesUserID, opts ...grpc.CallOption) (*BytesUser, error)
+	ListUsers(ctx context.Context, in *ListUsersRequest, opts ...grpc.CallOption) (*BytesUsers, error)
+	CreateUser(ctx context.Context, in *UserFields, opts ...grpc.CallOption) (*BytesUser, error)
+	UpdateUser(ctx context.Context, in *BytesUser, opts ...grpc.CallOption) (*BytesUser, error)
+	DeleteUser(ctx context.Context, in *BytesUserID, opts ...grpc.CallOption) (*emptypb.Empty, error)
+}
+
+type uuidUsersClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewUuidUsersClient(cc grpc.ClientConnInterface) UuidUsersClient {
+	return &uuidUsersClient{cc}
+}
+
+func (c *uuidUsersClient) GetUser(ctx context.Context, in *BytesUserID, opts ...grpc.CallOption) (*BytesUser, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BytesUser)
+	err := c.cc.Invoke(ctx, UuidUsers_GetUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *uuidUsersClient) ListUsers(ctx context.Context, in *ListUsersRequest, opts ...grpc.CallOption) (*BytesUsers, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BytesUsers)
+	err := c.cc.Invoke(ctx, UuidUsers_ListUsers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *uuidUsersClient) CreateUser(ctx context.Context, in *UserFields, opts ...grpc.CallOption) (*BytesUser, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BytesUser)
+	err := c.cc.Invoke(ctx, UuidUsers_CreateUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *uuidUsersClient) UpdateUser(ctx context.Context, in *BytesUser, opts ...grpc.CallOption) (*BytesUser, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BytesUser)
+	err := c.cc.Invoke(ctx, UuidUsers_UpdateUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *uuidUsersClient) DeleteUser(ctx context.Context, in *BytesUserID, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, UuidUsers_DeleteUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// UuidUsersServer is the server API for UuidUsers service.
+// All implementations should embed UnimplementedUuidUsersServer
+// for forward compatibility.
+type UuidUsersServer interface {
+	GetUser(context.Context, *BytesUserID) (*BytesUser, error)
+	ListUsers(context.Context, *ListUsersRequest) (*BytesUsers, error)
+	CreateUser(context.Context, *UserFields) (*BytesUser, error)
+	UpdateUser(context.Context, *BytesUser) (*BytesUser, error)
+	DeleteUser(context.Context, *BytesUserID) (*emptypb.Empty, error)
+}
+
+// UnimplementedUuidUsersServer should be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedUuidUsersServer struct{}
+
+func (UnimplementedUuidUsersServer) GetUser(context.Context, *BytesUserID) (*BytesUser, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUser not implemented")
+}
+func (UnimplementedUuidUsersServer) ListUsers(context.Context, *ListUsersRequest) (*BytesUsers, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListUsers not implemented")
+}
+func (UnimplementedUuidUsersServer) CreateUser(context.Context, *UserFields) (*BytesUser, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateUser not implemented")
+}
+func (UnimplementedUuidUsersServer) UpdateUser(context.Context, *BytesUser) (*BytesUser, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateUser not implemented")
+}
+func (UnimplementedUuidUsersServer) DeleteUser(context.Context, *BytesUserID) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteUser not implemented")
+}
+func (UnimplementedUuidUsersServer) testEmbeddedByValue() {}
+
+// UnsafeUuidUsersServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to UuidUsersServer will
+// result in compilation errors.
+type UnsafeUuidUsersServer interface {
+	mustEmbedUnimplementedUuidUsersServer()
+}
+
+func RegisterUuidUsersServer(s grpc.ServiceRegistrar, srv UuidUsersServer) {
+	// If the following call pancis, it indicates UnimplementedUuidUsersServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&UuidUsers_ServiceDesc, srv)
+}
+
+func _UuidUsers_GetUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BytesUserID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UuidUsersServer).GetUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UuidUsers_GetUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UuidUsersServer).GetUser(ctx, req.(*BytesUserID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UuidUsers_ListUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUsersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UuidUsersServer).ListUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UuidUsers_ListUsers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UuidUsersServer).ListUsers(ctx, req.(*ListUsersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UuidUsers_CreateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserFields)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UuidUsersServer).CreateUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UuidUsers_CreateUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UuidUsersServer).CreateUser(ctx, req.(*UserFields))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UuidUsers_UpdateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BytesUser)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UuidUsersServer).UpdateUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UuidUsers_UpdateUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UuidUsersServer).UpdateUser(ctx, req.(*BytesUser))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UuidUsers_DeleteUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BytesUserID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UuidUsersServer).DeleteUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UuidUsers_DeleteUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UuidUsersServer).DeleteUser(ctx, req.(*BytesUserID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// UuidUsers_ServiceDesc is the grpc.ServiceDesc for UuidUsers service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var UuidUsers_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "idtrials.v1.UuidUsers",
+	HandlerType: (*UuidUsersServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetUser",
+			Handler:    _UuidUsers_GetUser_Handler,
+		},
+		{
+			MethodName: "ListUsers",
+			Handler:    _UuidUsers_ListUsers_Handler,
+		},
+		{
+			MethodName: "CreateUser",
+			Handler:    _UuidUsers_CreateUser_Handler,
+		},
+		{
+			MethodName: "UpdateUser",
+			Handler:    _UuidUsers_UpdateUser_Handler,
+		},
+		{
+			MethodName: "DeleteUser",
+			Handler:    _UuidUsers_DeleteUser_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "idtrials/v1/users.proto",
+}
+
+const (
+	KsuidUsers_GetUser_FullMethodName    = "/idtrials.v1.KsuidUsers/GetUser"
+	KsuidUsers_ListUsers_FullMethodName  = "/idtrials.v1.KsuidUsers/ListUsers"
+	KsuidUsers_CreateUser_FullMethodName = "/idtrials.v1.KsuidUsers/CreateUser"
+	KsuidUsers_UpdateUser_FullMethodName = "/idtrials.v1.KsuidUsers/UpdateUser"
+	KsuidUsers_DeleteUser_FullMethodName = "/idtrials.v1.KsuidUsers/DeleteUser"
+)
+
+// KsuidUsersClient is the client API for KsuidUsers service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type KsuidUsersClient interface {
+	GetUser(ctx context.Context, in *BytesUserID, opts ...grpc.CallOption) (*BytesUser, error)
+	ListUsers(ctx context.Context, in *ListUsersRequest, opts ...grpc.CallOption) (*BytesUsers, error)
+	CreateUser(ctx context.Context, in *UserFields, opts ...grpc.CallOption) (*BytesUser, error)
+	UpdateUser(ctx context.Context, in *BytesUser, opts ...grpc.CallOption) (*BytesUser, error)
+	DeleteUser(ctx context.Context, in *BytesUserID, opts ...grpc.CallOption) (*emptypb.Empty, error)
+}
+
+type ksuidUsersClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewKsuidUsersClient(cc grpc.ClientConnInterface) KsuidUsersClient {
+	return &ksuidUsersClient{cc}
+}
+
+func (c *ksuidUsersClient) GetUser(ctx context.Context, in *BytesUserID, opts ...grpc.CallOption) (*BytesUser, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BytesUser)
+	err := c.cc.Invoke(ctx, KsuidUsers_GetUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ksuidUsersClient) ListUsers(ctx context.Context, in *ListUsersRequest, opts ...grpc.CallOption) (*BytesUsers, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BytesUsers)
+	err := c.cc.Invoke(ctx, KsuidUsers_ListUsers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ksuidUsersClient) CreateUser(ctx context.Context, in *UserFields, opts ...grpc.CallOption) (*BytesUser, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BytesUser)
+	err := c.cc.Invoke(ctx, KsuidUsers_CreateUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ksuidUsersClient) UpdateUser(ctx context.Context, in *BytesUser, opts ...grpc.CallOption) (*BytesUser, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BytesUser)
+	err := c.cc.Invoke(ctx, KsuidUsers_UpdateUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ksuidUsersClient) DeleteUser(ctx context.Context, in *BytesUserID, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, KsuidUsers_DeleteUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// KsuidUsersServer is the server API for KsuidUsers service.
+// All implementations should embed UnimplementedKsuidUsersServer
+// for forward compatibility.
+type KsuidUsersServer interface {
+	GetUser(context.Context, *BytesUserID) (*BytesUser, error)
+	ListUsers(context.Context, *ListUsersRequest) (*BytesUsers, error)
+	CreateUser(context.Context, *UserFields) (*BytesUser, error)
+	UpdateUser(context.Context, *BytesUser) (*BytesUser, error)
+	DeleteUser(context.Context, *BytesUserID) (*emptypb.Empty, error)
+}
+
+// UnimplementedKsuidUsersServer should be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedKsuidUsersServer struct{}
+
+func (UnimplementedKsuidUsersServer) GetUser(context.Context, *BytesUserID) (*BytesUser, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUser not implemented")
+}
+func (UnimplementedKsuidUsersServer) ListUsers(context.Context, *ListUsersRequest) (*BytesUsers, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListUsers not implemented")
+}
+func (UnimplementedKsuidUsersServer) CreateUser(context.Context, *UserFields) (*BytesUser, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateUser not implemented")
+}
+func (UnimplementedKsuidUsersServer) UpdateUser(context.Context, *BytesUser) (*BytesUser, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateUser not implemented")
+}
+func (UnimplementedKsuidUsersServer) DeleteUser(context.Context, *BytesUserID) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteUser not implemented")
+}
+func (UnimplementedKsuidUsersServer) testEmbeddedByValue() {}
+
+// UnsafeKsuidUsersServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to KsuidUsersServer will
+// result in compilation errors.
+type UnsafeKsuidUsersServer interface {
+	mustEmbedUnimplementedKsuidUsersServer()
+}
+
+func RegisterKsuidUsersServer(s grpc.ServiceRegistrar, srv KsuidUsersServer) {
+	// If the following call pancis, it indicates UnimplementedKsuidUsersServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&KsuidUsers_ServiceDesc, srv)
+}
+
+func _KsuidUsers_GetUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BytesUserID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KsuidUsersServer).GetUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: KsuidUsers_GetUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KsuidUsersServer).GetUser(ctx, req.(*BytesUserID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KsuidUsers_ListUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUsersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KsuidUsersServer).ListUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: KsuidUsers_ListUsers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KsuidUsersServer).ListUsers(ctx, req.(*ListUsersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KsuidUsers_CreateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserFields)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KsuidUsersServer).CreateUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: KsuidUsers_CreateUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KsuidUsersServer).CreateUser(ctx, req.(*UserFields))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KsuidUsers_UpdateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BytesUser)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KsuidUsersServer).UpdateUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: KsuidUsers_UpdateUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KsuidUsersServer).UpdateUser(ctx, req.(*BytesUser))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KsuidUsers_DeleteUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BytesUserID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KsuidUsersServer).DeleteUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: KsuidUsers_DeleteUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KsuidUsersServer).DeleteUser(ctx, req.(*BytesUserID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// KsuidUsers_ServiceDesc is the grpc.ServiceDesc for KsuidUsers service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var KsuidUsers_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "idtrials.v1.KsuidUsers",
+	HandlerType: (*KsuidUsersServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetUser",
+			Handler:    _KsuidUsers_GetUser_Handler,
+		},
+		{
+			MethodName: "ListUsers",
+			Handler:    _KsuidUsers_ListUsers_Handler,
+		},
+		{
+			MethodName: "CreateUser",
+			Handler:    _KsuidUsers_CreateUser_Handler,
+		},
+		{
+			MethodName: "UpdateUser",
+			Handler:    _KsuidUsers_UpdateUser_Handler,
+		},
+		{
+			MethodName: "DeleteUser",
+			Handler:    _KsuidUsers_DeleteUser_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "idtrials/v1/users.proto",
+}
+
+const (
+	CuidUsers_GetUser_FullMethodName    = "/idtrials.v1.CuidUsers/GetUser"
+	CuidUsers_ListUsers_FullMethodName  = "/idtrials.v1.CuidUsers/ListUsers"
+	CuidUsers_CreateUser_FullMethodName = "/idtrials.v1.CuidUsers/CreateUser"
+	CuidUsers_UpdateUser_FullMethodName = "/idtrials.v1.CuidUsers/UpdateUser"
+	CuidUsers_DeleteUser_FullMethodName = "/idtrials.v1.CuidUsers/DeleteUser"
+)
+
+// CuidUsersClient is the client API for CuidUsers service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type CuidUsersClient interface {
+	GetUser(ctx context.Context, in *StringUserID, opts ...grpc.CallOption) (*StringUser, error)
+	ListUsers(ctx context.Context, in *ListUsersRequest, opts ...grpc.CallOption) (*StringUsers, error)
+	CreateUser(ctx context.Context, in *UserFields, opts ...grpc.CallOption) (*StringUser, error)
+	UpdateUser(ctx context.Context, in *StringUser, opts ...grpc.CallOption) (*StringUser, error)
+	DeleteUser(ctx context.Context, in *StringUserID, opts ...grpc.CallOption) (*emptypb.Empty, error)
+}
+
+type cuidUsersClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCuidUsersClient(cc grpc.ClientConnInterface) CuidUsersClient {
+	return &cuidUsersClient{cc}
+}
+
+func (c *cuidUsersClient) GetUser(ctx context.Context, in *StringUserID, opts ...grpc.CallOption) (*StringUser, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StringUser)
+	err := c.cc.Invoke(ctx, CuidUsers_GetUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cuidUsersClient) ListUsers(ctx context.Context, in *ListUsersRequest, opts ...grpc.CallOption) (*StringUsers, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StringUsers)
+	err := c.cc.Invoke(ctx, CuidUsers_ListUsers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cuidUsersClient) CreateUser(ctx context.Context, in *UserFields, opts ...grpc.CallOption) (*StringUser, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StringUser)
+	err := c.cc.Invoke(ctx, CuidUsers_CreateUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cuidUsersClient) UpdateUser(ctx context.Context, in *StringUser, opts ...grpc.CallOption) (*StringUser, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StringUser)
+	err := c.cc.Invoke(ctx, CuidUsers_UpdateUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cuidUsersClient) DeleteUser(ctx context.Context, in *StringUserID, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, CuidUsers_DeleteUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CuidUsersServer is the server API for CuidUsers service.
+// All implementations should embed UnimplementedCuidUsersServer
+// for forward compatibility.
+type CuidUsersServer interface {
+	GetUser(context.Context, *StringUserID) (*StringUser, error)
+	ListUsers(context.Context, *ListUsersRequest) (*StringUsers, error)
+	CreateUser(context.Context, *UserFields) (*StringUser, error)
+	UpdateUser(context.Context, *StringUser) (*StringUser, error)
+	DeleteUser(context.Context, *StringUserID) (*emptypb.Empty, error)
+}
+
+// UnimplementedCuidUsersServer should be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedCuidUsersServer struct{}
+
+func (UnimplementedCuidUsersServer) GetUser(context.Context, *StringUserID) (*StringUser, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUser not implemented")
+}
+func (UnimplementedCuidUsersServer) ListUsers(context.Context, *ListUsersRequest) (*StringUsers, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListUsers not implemented")
+}
+func (UnimplementedCuidUsersServer) CreateUser(context.Context, *UserFields) (*StringUser, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateUser not implemented")
+}
+func (UnimplementedCuidUsersServer) UpdateUser(context.Context, *StringUser) (*StringUser, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateUser not implemented")
+}
+func (UnimplementedCuidUsersServer) DeleteUser(context.Context, *StringUserID) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteUser not implemented")
+}
+func (UnimplementedCuidUsersServer) testEmbeddedByValue() {}
+
+// UnsafeCuidUsersServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CuidUsersServer will
+// result in compilation errors.
+type UnsafeCuidUsersServer interface {
+	mustEmbedUnimplementedCuidUsersServer()
+}
+
+func RegisterCuidUsersServer(s grpc.ServiceRegistrar, srv CuidUsersServer) {
+	// If the following call pancis, it indicates UnimplementedCuidUsersServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&CuidUsers_ServiceDesc, srv)
+}
+
+func _CuidUsers_GetUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StringUserID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CuidUsersServer).GetUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CuidUsers_GetUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CuidUsersServer).GetUser(ctx, req.(*StringUserID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CuidUsers_ListUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUsersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CuidUsersServer).ListUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CuidUsers_ListUsers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CuidUsersServer).ListUsers(ctx, req.(*ListUsersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CuidUsers_CreateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserFields)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CuidUsersServer).CreateUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CuidUsers_CreateUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CuidUsersServer).CreateUser(ctx, req.(*UserFields))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CuidUsers_UpdateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StringUser)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CuidUsersServer).UpdateUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CuidUsers_UpdateUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CuidUsersServer).UpdateUser(ctx, req.(*StringUser))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CuidUsers_DeleteUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StringUserID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CuidUsersServer).DeleteUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CuidUsers_DeleteUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CuidUsersServer).DeleteUser(ctx, req.(*StringUserID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// CuidUsers_ServiceDesc is the grpc.ServiceDesc for CuidUsers service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var CuidUsers_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "idtrials.v1.CuidUsers",
+	HandlerType: (*CuidUsersServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetUser",
+			Handler:    _CuidUsers_GetUser_Handler,
+		},
+		{
+			MethodName: "ListUsers",
+			Handler:    _CuidUsers_ListUsers_Handler,
+		},
+		{
+			MethodName: "CreateUser",
+			Handler:    _CuidUsers_CreateUser_Handler,
+		},
+		{
+			MethodName: "UpdateUser",
+			Handler:    _CuidUsers_UpdateUser_Handler,
+		},
+		{
+			MethodName: "DeleteUser",
+			Handler:    _CuidUsers_DeleteUser_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "idtrials/v1/users.proto",
+}
+
+const (
+	NanoidUsers_GetUser_FullMethodName    = "/idtrials.v1.NanoidUsers/GetUser"
+	NanoidUsers_ListUsers_FullMethodName  = "/idtrials.v1.NanoidUsers/ListUsers"
+	NanoidUsers_CreateUser_FullMethodName = "/idtrials.v1.NanoidUsers/CreateUser"
+	NanoidUsers_UpdateUser_FullMethodName = "/idtrials.v1.NanoidUsers/UpdateUser"
+	NanoidUsers_DeleteUser_FullMethodName = "/idtrials.v1.NanoidUsers/DeleteUser"
+)
+
+// NanoidUsersClient is the client API for NanoidUsers service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type NanoidUsersClient interface {
+	GetUser(ctx context.Context, in *StringUserID, opts ...grpc.CallOption) (*StringUser, error)
+	ListUsers(ctx context.Context, in *ListUsersRequest, opts ...grpc.CallOption) (*StringUsers, error)
+	CreateUser(ctx context.Context, in *UserFields, opts ...grpc.CallOption) (*StringUser, error)
+	UpdateUser(ctx context.Context, in *StringUser, opts ...grpc.CallOption) (*StringUser, error)
+	DeleteUser(ctx context.Context, in *StringUserID, opts ...grpc.CallOption) (*emptypb.Empty, error)
+}
+
+type nanoidUsersClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewNanoidUsersClient(cc grpc.ClientConnInterface) NanoidUsersClient {
+	return &nanoidUsersClient{cc}
+}
+
+func (c *nanoidUsersClient) GetUser(ctx context.Context, in *StringUserID, opts ...grpc.CallOption) (*StringUser, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StringUser)
+	err := c.cc.Invoke(ctx, NanoidUsers_GetUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nanoidUsersClient) ListUsers(ctx context.Context, in *ListUsersRequest, opts ...grpc.CallOption) (*StringUsers, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StringUsers)
+	err := c.cc.Invoke(ctx, NanoidUsers_ListUsers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nanoidUsersClient) CreateUser(ctx context.Context, in *UserFields, opts ...grpc.CallOption) (*StringUser, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StringUser)
+	err := c.cc.Invoke(ctx, NanoidUsers_CreateUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nanoidUsersClient) UpdateUser(ctx context.Context, in *StringUser, opts ...grpc.CallOption) (*StringUser, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StringUser)
+	err := c.cc.Invoke(ctx, NanoidUsers_UpdateUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nanoidUsersClient) DeleteUser(ctx context.Context, in *StringUserID, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, NanoidUsers_DeleteUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// NanoidUsersServer is the server API for NanoidUsers service.
+// All implementations should embed UnimplementedNanoidUsersServer
+// for forward compatibility.
+type NanoidUsersServer interface {
+	GetUser(context.Context, *StringUserID) (*StringUser, error)
+	ListUsers(context.Context, *ListUsersRequest) (*StringUsers, error)
+	CreateUser(context.Context, *UserFields) (*StringUser, error)
+	UpdateUser(context.Context, *StringUser) (*StringUser, error)
+	DeleteUser(context.Context, *StringUserID) (*emptypb.Empty, error)
+}
+
+// UnimplementedNanoidUsersServer should be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedNanoidUsersServer struct{}
+
+func (UnimplementedNanoidUsersServer) GetUser(context.Context, *StringUserID) (*StringUser, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUser not implemented")
+}
+func (UnimplementedNanoidUsersServer) ListUsers(context.Context, *ListUsersRequest) (*StringUsers, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListUsers not implemented")
+}
+func (UnimplementedNanoidUsersServer) CreateUser(context.Context, *UserFields) (*StringUser, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateUser not implemented")
+}
+func (UnimplementedNanoidUsersServer) UpdateUser(context.Context, *StringUser) (*StringUser, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateUser not implemented")
+}
+func (UnimplementedNanoidUsersServer) DeleteUser(context.Context, *StringUserID) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteUser not implemented")
+}
+func (UnimplementedNanoidUsersServer) testEmbeddedByValue() {}
+
+// UnsafeNanoidUsersServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to NanoidUsersServer will
+// result in compilation errors.
+type UnsafeNanoidUsersServer interface {
+	mustEmbedUnimplementedNanoidUsersServer()
+}
+
+func RegisterNanoidUsersServer(s grpc.ServiceRegistrar, srv NanoidUsersServer) {
+	// If the following call pancis, it indicates UnimplementedNanoidUsersServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&NanoidUsers_ServiceDesc, srv)
+}
+
+func _NanoidUsers_GetUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StringUserID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NanoidUsersServer).GetUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NanoidUsers_GetUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NanoidUsersServer).GetUser(ctx, req.(*StringUserID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NanoidUsers_ListUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUsersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NanoidUsersServer).ListUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NanoidUsers_ListUsers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NanoidUsersServer).ListUsers(ctx, req.(*ListUsersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NanoidUsers_CreateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserFields)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NanoidUsersServer).CreateUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NanoidUsers_CreateUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NanoidUsersServer).CreateUser(ctx, req.(*UserFields))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NanoidUsers_UpdateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StringUser)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NanoidUsersServer).UpdateUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NanoidUsers_UpdateUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NanoidUsersServer).UpdateUser(ctx, req.(*StringUser))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NanoidUsers_DeleteUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StringUserID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NanoidUsersServer).DeleteUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NanoidUsers_DeleteUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NanoidUsersServer).DeleteUser(ctx, req.(*StringUserID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// NanoidUsers_ServiceDesc is the grpc.ServiceDesc for NanoidUsers service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var NanoidUsers_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "idtrials.v1.NanoidUsers",
+	HandlerType: (*NanoidUsersServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetUser",
+			Handler:    _NanoidUsers_GetUser_Handler,
+		},
+		{
+			MethodName: "ListUsers",
+			Handler:    _NanoidUsers_ListUsers_Handler,
+		},
+		{
+			MethodName: "CreateUser",
+			Handler:    _NanoidUsers_CreateUser_Handler,
+		},
+		{
+			MethodName: "UpdateUser",
+			Handler:    _NanoidUsers_UpdateUser_Handler,
+		},
+		{
+			MethodName: "DeleteUser",
+			Handler:    _NanoidUsers_DeleteUser_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "idtrials/v1/users.proto",
+}
+
+const (
+	SnowflakeUsers_GetUser_FullMethodName    = "/idtrials.v1.SnowflakeUsers/GetUser"
+	SnowflakeUsers_ListUsers_FullMethodName  = "/idtrials.v1.SnowflakeUsers/ListUsers"
+	SnowflakeUsers_CreateUser_FullMethodName = "/idtrials.v1.SnowflakeUsers/CreateUser"
+	SnowflakeUsers_UpdateUser_FullMethodName = "/idtrials.v1.SnowflakeUsers/UpdateUser"
+	SnowflakeUsers_DeleteUser_FullMethodName = "/idtrials.v1.SnowflakeUsers/DeleteUser"
+)
+
+// SnowflakeUsersClient is the client API for SnowflakeUsers service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type SnowflakeUsersClient interface {
+	GetUser(ctx context.Context, in *Int64UserID, opts ...grpc.CallOption) (*Int64User, error)
+	ListUsers(ctx context.Context, in *ListUsersRequest, opts ...grpc.CallOption) (*Int64Users, error)
+	CreateUser(ctx context.Context, in *UserFields, opts ...grpc.CallOption) (*Int64User, error)
+	UpdateUser(ctx context.Context, in *Int64User, opts ...grpc.CallOption) (*Int64User, error)
+	DeleteUser(ctx context.Context, in *Int64UserID, opts ...grpc.CallOption) (*emptypb.Empty, error)
+}
+
+type snowflakeUsersClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewSnowflakeUsersClient(cc grpc.ClientConnInterface) SnowflakeUsersClient {
+	return &snowflakeUsersClient{cc}
+}
+
+func (c *snowflakeUsersClient) GetUser(ctx context.Context, in *Int64UserID, opts ...grpc.CallOption) (*Int64User, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Int64User)
+	err := c.cc.Invoke(ctx, SnowflakeUsers_GetUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *snowflakeUsersClient) ListUsers(ctx context.Context, in *ListUsersRequest, opts ...grpc.CallOption) (*Int64Users, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Int64Users)
+	err := c.cc.Invoke(ctx, SnowflakeUsers_ListUsers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *snowflakeUsersClient) CreateUser(ctx context.Context, in *UserFields, opts ...grpc.CallOption) (*Int64User, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Int64User)
+	err := c.cc.Invoke(ctx, SnowflakeUsers_CreateUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *snowflakeUsersClient) UpdateUser(ctx context.Context, in *Int64User, opts ...grpc.CallOption) (*Int64User, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Int64User)
+	err := c.cc.Invoke(ctx, SnowflakeUsers_UpdateUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *snowflakeUsersClient) DeleteUser(ctx context.Context, in *Int64UserID, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, SnowflakeUsers_DeleteUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SnowflakeUsersServer is the server API for SnowflakeUsers service.
+// All implementations should embed UnimplementedSnowflakeUsersServer
+// for forward compatibility.
+type SnowflakeUsersServer interface {
+	GetUser(context.Context, *Int64UserID) (*Int64User, error)
+	ListUsers(context.Context, *ListUsersRequest) (*Int64Users, error)
+	CreateUser(context.Context, *UserFields) (*Int64User, error)
+	UpdateUser(context.Context, *Int64User) (*Int64User, error)
+	DeleteUser(context.Context, *Int64UserID) (*emptypb.Empty, error)
+}
+
+// UnimplementedSnowflakeUsersServer should be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedSnowflakeUsersServer struct{}
+
+func (UnimplementedSnowflakeUsersServer) GetUser(context.Context, *Int64UserID) (*Int64User, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUser not implemented")
+}
+func (UnimplementedSnowflakeUsersServer) ListUsers(context.Context, *ListUsersRequest) (*Int64Users, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListUsers not implemented")
+}
+func (UnimplementedSnowflakeUsersServer) CreateUser(context.Context, *UserFields) (*Int64User, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateUser not implemented")
+}
+func (UnimplementedSnowflakeUsersServer) UpdateUser(context.Context, *Int64User) (*Int64User, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateUser not implemented")
+}
+func (UnimplementedSnowflakeUsersServer) DeleteUser(context.Context, *Int64UserID) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteUser not implemented")
+}
+func (UnimplementedSnowflakeUsersServer) testEmbeddedByValue() {}
+
+// UnsafeSnowflakeUsersServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SnowflakeUsersServer will
+// result in compilation errors.
+type UnsafeSnowflakeUsersServer interface {
+	mustEmbedUnimplementedSnowflakeUsersServer()
+}
+
+func RegisterSnowflakeUsersServer(s grpc.ServiceRegistrar, srv SnowflakeUsersServer) {
+	// If the following call pancis, it indicates UnimplementedSnowflakeUsersServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&SnowflakeUsers_ServiceDesc, srv)
+}
+
+func _SnowflakeUsers_GetUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Int64UserID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SnowflakeUsersServer).GetUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SnowflakeUsers_GetUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SnowflakeUsersServer).GetUser(ctx, req.(*Int64UserID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SnowflakeUsers_ListUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUsersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SnowflakeUsersServer).ListUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SnowflakeUsers_ListUsers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SnowflakeUsersServer).ListUsers(ctx, req.(*ListUsersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SnowflakeUsers_CreateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserFields)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SnowflakeUsersServer).CreateUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SnowflakeUsers_CreateUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SnowflakeUsersServer).CreateUser(ctx, req.(*UserFields))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SnowflakeUsers_UpdateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Int64User)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SnowflakeUsersServer).UpdateUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SnowflakeUsers_UpdateUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SnowflakeUsersServer).UpdateUser(ctx, req.(*Int64User))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SnowflakeUsers_DeleteUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Int64UserID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SnowflakeUsersServer).DeleteUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SnowflakeUsers_DeleteUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SnowflakeUsersServer).DeleteUser(ctx, req.(*Int64UserID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// SnowflakeUsers_ServiceDesc is the grpc.ServiceDesc for SnowflakeUsers service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var SnowflakeUsers_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "idtrials.v1.SnowflakeUsers",
+	HandlerType: (*SnowflakeUsersServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetUser",
+			Handler:    _SnowflakeUsers_GetUser_Handler,
+		},
+		{
+			MethodName: "ListUsers",
+			Handler:    _SnowflakeUsers_ListUsers_Handler,
+		},
+		{
+			MethodName: "CreateUser",
+			Handler:    _SnowflakeUsers_CreateUser_Handler,
+		},
+		{
+			MethodName: "UpdateUser",
+			Handler:    _SnowflakeUsers_UpdateUser_Handler,
+		},
+		{
+			MethodName: "DeleteUser",
+			Handler:    _SnowflakeUsers_DeleteUser_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "idtrials/v1/users.proto",
+}
@@ -19,7 +19,7 @@ func NewMetricsRepository(db *gorm.DB) *MetricsRepository {
 
 // Get average response time by ID type
 func (r *MetricsRepository) GetAverageDurationByIDType() ([]stats.IDTypePerformance, error) {
-	var results []stats.IDTypePerformance
+	results := []stats.IDTypePerformance{}
 
 	err := r.DB.Model(&models.RouteMetric{}).
 		Select("id_type, AVG(total_duration) as avg_duration, COUNT(*) as request_count").
@@ -32,7 +32,7 @@ func (r *MetricsRepository) GetAverageDurationByIDType() ([]stats.IDTypePerforma
 
 // Get performance by route and operation
 func (r *MetricsRepository) GetPerformanceByRoute(idType string) ([]stats.RoutePerformance, error) {
-	var results []stats.RoutePerformance
+	results := []stats.RoutePerformance{}
 
 	err := r.DB.Model(&models.RouteMetric{}).
 		Select(`
@@ -52,7 +52,7 @@ func (r *MetricsRepository) GetPerformanceByRoute(idType string) ([]stats.RouteP
 	return results, err
 }
 
-// Get percentile performance
+// Get percentile performance of the HTTP methods; gRPC calls, all POSTs, are left out
 func (r *MetricsRepository) GetPercentiles(idType string, hours int) (*map[string][]stats.PercentilePoint, error) {
 	// var result []stats.PercentileStats
 	methods := []string{"GET", "POST", "PUT", "DELETE"}
@@ -62,8 +62,8 @@ func (r *MetricsRepository) GetPercentiles(idType string, hours int) (*map[strin
 		var durations []float64
 		err := r.DB.Model(&models.RouteMetric{}).
 			Select("total_duration").
-			Where("id_type = ? AND is_error = ? AND timestamp >= ? AND http_method = ?",
-				idType, false, time.Now().Add(-time.Duration(hours)*time.Hour), method).
+			Where("id_type = ? AND is_error = ? AND timestamp >= ? AND http_method = ? AND protocol = ?",
+				idType, false, time.Now().Add(-time.Duration(hours)*time.Hour), method, models.ProtocolHTTP).
 			Order("total_duration ASC").
 			Pluck("total_duration", &durations).Error
 
@@ -79,7 +79,7 @@ func (r *MetricsRepository) GetPercentiles(idType string, hours int) (*map[strin
 
 // Get time series data (for charts)
 func (r *MetricsRepository) GetErrorRateTrend(idType string) ([]stats.ErrorRateTrend, error) {
-	var results []stats.ErrorRateTrend
+	results := []stats.ErrorRateTrend{}
 
 	err := r.DB.Model(&models.RouteMetric{}).
 		Select(`
@@ -98,7 +98,7 @@ func (r *MetricsRepository) GetErrorRateTrend(idType string) ([]stats.ErrorRateT
 }
 
 func (r *MetricsRepository) GetIdDurationTrend(idType string) ([]stats.PercentileTrend, error) {
-	var results []stats.PercentileTrend
+	results := []stats.PercentileTrend{}
 
 	err := r.DB.Model(&models.RouteMetric{}).
 		Select(`
@@ -117,7 +117,7 @@ func (r *MetricsRepository) GetIdDurationTrend(idType string) ([]stats.Percentil
 }
 
 func (r *MetricsRepository) GetSpecificTableSizes() ([]stats.TableSize, error) {
-	var sizes []stats.TableSize
+	sizes := []stats.TableSize{}
 
 	err := r.DB.Raw(`
 		SELECT
@@ -138,7 +138,7 @@ func (r *MetricsRepository) GetSpecificTableSizes() ([]stats.TableSize, error) {
 }
 
 func (r *MetricsRepository) GetIdEfficiencyMetrics() ([]stats.IDEfficiency, error) {
-	var results []stats.IDEfficiency
+	results := []stats.IDEfficiency{}
 
 	err := r.DB.Raw(`
 		WITH id_stats AS (
@@ -176,3 +176,325 @@ func (r *MetricsRepository) GetIdEfficiencyMetrics() ([]stats.IDEfficiency, erro
 
 	return results, err
 }
+
+// Get the latest write amplification benchmark result for each ID type
+func (r *MetricsRepository) GetWriteAmplification() ([]stats.WriteAmplification, error) {
+	results := []stats.WriteAmplification{}
+
+	// Plain SQL rather than DISTINCT ON, so the aggregation also runs on SQLite
+	err := r.DB.Raw(`
+		SELECT
+			run_id,
+			id_type,
+			table_name,
+			records,
+			ROUND(1.0 * wal_bytes / NULLIF(records, 0), 2) AS wal_bytes_per_insert,
+			ROUND(1.0 * wal_fpi / NULLIF(records, 0), 4) AS wal_fpi_per_insert,
+			index_blocks_read + index_blocks_hit AS index_blocks_touched,
+			index_pages_after - index_pages_before AS index_pages_added,
+			COALESCE(ROUND(
+				100.0 * (index_blocks_hit + heap_blocks_hit) /
+				NULLIF(index_blocks_hit + heap_blocks_hit + index_blocks_read + heap_blocks_read, 0)
+			, 2), 100) AS buffer_hit_ratio,
+			duration,
+			timestamp
+		FROM write_benchmarks w
+		WHERE id = (
+			SELECT latest.id FROM write_benchmarks latest
+			WHERE latest.id_type = w.id_type
+			ORDER BY latest.timestamp DESC, latest.id DESC
+			LIMIT 1
+		)
+		ORDER BY id_type
+	`).Scan(&results).Error
+
+	return results, err
+}
+
+// Summarize captured query plans by operation for an ID type
+func (r *MetricsRepository) GetQueryPlanStats(idType string) ([]stats.QueryPlanStats, error) {
+	results := []stats.QueryPlanStats{}
+
+	err := r.DB.Model(&models.QueryPlan{}).
+		Select(`
+			id_type,
+			operation,
+			COUNT(*) as plan_count,
+			SUM(CASE WHEN uses_index_scan THEN 1 ELSE 0 END) as index_scan_count,
+			SUM(CASE WHEN uses_seq_scan THEN 1 ELSE 0 END) as seq_scan_count,
+			AVG(shared_hit_blocks) as avg_shared_hit,
+			AVG(shared_read_blocks) as avg_shared_read,
+			COALESCE(ROUND(100.0 * SUM(shared_hit_blocks) / NULLIF(SUM(shared_hit_blocks + shared_read_blocks), 0), 2), 100) as buffer_hit_ratio,
+			AVG(execution_time) as avg_execution_time
+		`).
+		Where("id_type = ?", idType).
+		Group("id_type, operation").
+		Scan(&results).Error
+
+	return results, err
+}
+
+// Get the most recently captured query plans for an ID type
+func (r *MetricsRepository) GetRecentQueryPlans(idType string, limit int) ([]models.QueryPlan, error) {
+	plans := []models.QueryPlan{}
+
+	err := r.DB.Where("id_type = ?", idType).
+		Order("timestamp DESC").
+		Limit(limit).
+		Find(&plans).Error
+
+	return plans, err
+}
+
+// Compare foreign key index size and users-orders join latency per ID type
+func (r *MetricsRepository) GetForeignKeyCost() ([]stats.ForeignKeyCost, error) {
+	results := []stats.ForeignKeyCost{}
+
+	err := r.DB.Raw(`
+		WITH fk_indexes AS (
+			SELECT
+				i.relname AS table_name,
+				i.indexrelname AS index_name,
+				t.n_live_tup AS row_count,
+				pg_relation_size(i.indexrelid) AS index_bytes
+			FROM pg_stat_user_indexes i
+			JOIN pg_stat_user_tables t ON t.relid = i.relid
+			WHERE i.schemaname = 'public'
+				AND i.relname LIKE 'orders%'
+				AND i.indexrelname LIKE '%user_id'
+		),
+		joins AS (
+			SELECT
+				'orders_' || LOWER(id_type) AS table_name,
+				id_type,
+				COUNT(*) AS join_requests,
+				AVG(total_duration) AS avg_join_duration,
+				PERCENTILE_CONT(0.95) WITHIN GROUP (ORDER BY total_duration) AS p95_join_duration
+			FROM route_metrics
+			WHERE http_method = 'GET' AND route_path LIKE '%/orders' AND is_error = false
+			GROUP BY id_type
+		)
+		SELECT
+			COALESCE(j.id_type, f.table_name) AS id_type,
+			f.table_name,
+			f.index_name,
+			f.row_count,
+			f.index_bytes,
+			pg_size_pretty(f.index_bytes) AS index_pretty,
+			ROUND((f.index_bytes::numeric / NULLIF(f.row_count, 0)), 2) AS bytes_per_row,
+			COALESCE(j.join_requests, 0) AS join_requests,
+			COALESCE(j.avg_join_duration, 0) AS avg_join_duration,
+			COALESCE(j.p95_join_duration, 0) AS p95_join_duration
+		FROM fk_indexes f
+		LEFT JOIN joins j ON j.table_name = f.table_name
+		ORDER BY f.index_bytes DESC
+	`).Scan(&results).Error
+
+	return results, err
+}
+
+// Compare a recent time-window scan on each partitioned table with its unpartitioned counterpart
+func (r *MetricsRepository) GetPartitionPruning(hours int) ([]stats.PartitionPruning, error) {
+	results := make([]stats.PartitionPruning, 0, len(PartitionSpecs))
+	partitionRepo := NewPartitionRepository(r.DB)
+	since := time.Now().Add(-time.Duration(hours) * time.Hour)
+
+	for _, spec := range PartitionSpecs {
+		if !r.DB.Migrator().HasTable(spec.Table) {
+			continue
+		}
+
+		partitions, err := partitionRepo.ListPartitions(spec)
+		if err != nil {
+			return nil, err
+		}
+		partitioned, err := partitionRepo.ExplainRange(spec.Table, spec, since)
+		if err != nil {
+			return nil, err
+		}
+		unpartitioned, err := partitionRepo.ExplainRange(spec.Source, spec, since)
+		if err != nil {
+			return nil, err
+		}
+
+		var durations struct {
+			PartitionedAvgDuration float64
+			HeapAvgDuration        float64
+		}
+		err = r.DB.Model(&models.RouteMetric{}).
+			Select(`
+				COALESCE(AVG(CASE WHEN storage_mode = 'partitioned' THEN total_duration END), 0) as partitioned_avg_duration,
+				COALESCE(AVG(CASE WHEN storage_mode = 'heap' THEN total_duration END), 0) as heap_avg_duration
+			`).
+			Where("id_type = ? AND is_error = ? AND timestamp >= ?", spec.IDType, false, since).
+			Scan(&durations).Error
+		if err != nil {
+			return nil, err
+		}
+
+		results = append(results, stats.PartitionPruning{
+			IDType:                 spec.IDType,
+			TableName:              spec.Table,
+			PartitionCount:         int64(len(partitions)),
+			WindowHours:            hours,
+			PartitionsScanned:      len(partitioned.Relations),
+			PartitionedExecution:   partitioned.ExecutionTime,
+			PartitionedBlocks:      partitioned.SharedHitBlocks + partitioned.SharedReadBlocks,
+			UnpartitionedExecution: unpartitioned.ExecutionTime,
+			UnpartitionedBlocks:    unpartitioned.SharedHitBlocks + unpartitioned.SharedReadBlocks,
+			PartitionedAvgDuration: durations.PartitionedAvgDuration,
+			HeapAvgDuration:        durations.HeapAvgDuration,
+		})
+	}
+
+	return results, nil
+}
+
+// Get list latency of searched requests per ID type and search mode
+func (r *MetricsRepository) GetSearchPerformance() ([]stats.SearchPerformance, error) {
+	results := []stats.SearchPerformance{}
+
+	err := r.DB.Model(&models.RouteMetric{}).
+		Select(`
+			id_type,
+			search_mode,
+			COUNT(*) as request_count,
+			AVG(total_duration) as avg_duration,
+			PERCENTILE_CONT(0.5) WITHIN GROUP (ORDER BY total_duration) as median,
+			PERCENTILE_CONT(0.95) WITHIN GROUP (ORDER BY total_duration) as p95,
+			AVG(db_query_duration) as avg_db_query
+		`).
+		Where("search_mode <> ? AND http_method = ? AND is_error = ?", "none", "GET", false).
+		Group("id_type, search_mode").
+		Order("id_type, search_mode").
+		Scan(&results).Error
+
+	return results, err
+}
+
+// Get PUT and PATCH latency per ID type, split by whether the write was conditional
+func (r *MetricsRepository) GetConditionalWrites() ([]stats.ConditionalWrites, error) {
+	results := []stats.ConditionalWrites{}
+
+	err := r.DB.Model(&models.RouteMetric{}).
+		Select(`
+			id_type,
+			http_method,
+			conditional,
+			COUNT(*) as request_count,
+			COUNT(*) FILTER (WHERE status_code = 412) as precondition_failed,
+			AVG(total_duration) as avg_duration,
+			PERCENTILE_CONT(0.95) WITHIN GROUP (ORDER BY total_duration) as p95,
+			AVG(db_query_duration) as avg_db_query
+		`).
+		Where("http_method IN ?", []string{"PUT", "PATCH"}).
+		Group("id_type, http_method, conditional").
+		Order("id_type, http_method, conditional").
+		Scan(&results).Error
+
+	return results, err
+}
+
+// Get latency and response size per ID type, split by the protocol the request arrived over
+func (r *MetricsRepository) GetProtocolComparison() ([]stats.ProtocolComparison, error) {
+	results := []stats.ProtocolComparison{}
+
+	err := r.DB.Model(&models.RouteMetric{}).
+		Select(`
+			id_type,
+			protocol,
+			COUNT(*) as request_count,
+			COUNT(*) FILTER (WHERE is_error) as error_count,
+			AVG(total_duration) as avg_duration,
+			PERCENTILE_CONT(0.5) WITHIN GROUP (ORDER BY total_duration) as median,
+			PERCENTILE_CONT(0.95) WITHIN GROUP (ORDER BY total_duration) as p95,
+			AVG(response_size) as avg_response_size
+		`).
+		Group("id_type, protocol").
+		Order("id_type, protocol").
+		Scan(&results).Error
+
+	return results, err
+}
+
+// Get latency per ID type next to the connection pool state each request met
+func (r *MetricsRepository) GetPoolSaturation(hours int) ([]stats.PoolSaturation, error) {
+	results := []stats.PoolSaturation{}
+	since := time.Now().Add(-time.Duration(hours) * time.Hour)
+
+	err := r.DB.Model(&models.RouteMetric{}).
+		Select(`
+			id_type,
+			COUNT(*) as request_count,
+			AVG(total_duration) as avg_duration,
+			PERCENTILE_CONT(0.95) WITHIN GROUP (ORDER BY total_duration) as p95,
+			AVG(db_query_duration) as avg_db_query,
+			AVG(pool_in_use) as avg_pool_in_use,
+			COALESCE(AVG(pool_in_use::decimal / NULLIF(pool_max_open, 0)), 0) as avg_saturation,
+			COUNT(*) FILTER (WHERE pool_wait_count > 0) as waited_count,
+			COALESCE(AVG(total_duration) FILTER (WHERE pool_wait_count > 0), 0) as waited_avg_duration,
+			COALESCE(AVG(total_duration) FILTER (WHERE pool_wait_count = 0), 0) as unwaited_avg_duration,
+			AVG(pool_wait_duration) as avg_pool_wait
+		`).
+		Where("is_error = ? AND timestamp >= ?", false, since).
+		Group("id_type").
+		Order("id_type").
+		Scan(&results).Error
+
+	return results, err
+}
+
+// Get pool samples and request latency per minute
+func (r *MetricsRepository) GetPoolTimeline(hours int) ([]stats.PoolTimelinePoint, error) {
+	results := []stats.PoolTimelinePoint{}
+	since := time.Now().Add(-time.Duration(hours) * time.Hour)
+
+	// Wait counters are cumulative, so each sample contributes its increase
+	// over the previous one; a drop means the server restarted
+	err := r.DB.Raw(`
+		WITH samples AS (
+			SELECT
+				timestamp,
+				max_open,
+				in_use,
+				GREATEST(wait_count - LAG(wait_count, 1, wait_count) OVER (ORDER BY timestamp), 0) as waits,
+				GREATEST(wait_duration - LAG(wait_duration, 1, wait_duration) OVER (ORDER BY timestamp), 0) as wait_duration
+			FROM pool_stats
+			WHERE timestamp >= ?
+		), pool AS (
+			SELECT
+				DATE_TRUNC('minute', timestamp) as time_bucket,
+				MAX(max_open) as max_open,
+				AVG(in_use) as avg_in_use,
+				MAX(in_use) as max_in_use,
+				SUM(waits) as waits,
+				SUM(wait_duration) as wait_duration
+			FROM samples
+			GROUP BY 1
+		), requests AS (
+			SELECT
+				DATE_TRUNC('minute', timestamp) as time_bucket,
+				COUNT(*) as request_count,
+				AVG(total_duration) as avg_duration,
+				PERCENTILE_CONT(0.95) WITHIN GROUP (ORDER BY total_duration) as p95
+			FROM route_metrics
+			WHERE is_error = false AND timestamp >= ?
+			GROUP BY 1
+		)
+		SELECT
+			COALESCE(pool.time_bucket, requests.time_bucket) as time_bucket,
+			COALESCE(pool.max_open, 0) as max_open,
+			COALESCE(pool.avg_in_use, 0) as avg_in_use,
+			COALESCE(pool.max_in_use, 0) as max_in_use,
+			COALESCE(pool.waits, 0) as waits,
+			COALESCE(pool.wait_duration, 0) as wait_duration,
+			COALESCE(requests.request_count, 0) as request_count,
+			COALESCE(requests.avg_duration, 0) as avg_duration,
+			COALESCE(requests.p95, 0) as p95
+		FROM pool
+		FULL OUTER JOIN requests ON pool.time_bucket = requests.time_bucket
+		ORDER BY 1
+	`, since, since).Scan(&results).Error
+
+	return results, err
+}
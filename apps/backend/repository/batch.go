@@ -0,0 +1,114 @@
+package repository
+
+import (
+	"gorm.io/gorm"
+)
+
+// batchInsertSize is the number of rows per multi-row INSERT, well below the
+// 65535 bind parameters Postgres accepts in one statement.
+const batchInsertSize = 1000
+
+// BatchResult is the outcome of one user of a bulk create, in request order.
+type BatchResult[T any] struct {
+	User *T
+	Err  error
+}
+
+// createUsers inserts users with multi-row INSERTs. An atomic batch runs in one
+// transaction and fails as a whole. Otherwise a chunk that fails is retried row
+// by row, so only the offending rows report an error.
+func createUsers[T any](db *gorm.DB, users []T, atomic bool) ([]BatchResult[T], error) {
+	results := make([]BatchResult[T], len(users))
+	if len(users) == 0 {
+		return results, nil
+	}
+
+	if atomic {
+		err := db.Transaction(func(tx *gorm.DB) error {
+			return tx.CreateInBatches(&users, batchInsertSize).Error
+		})
+		if err != nil {
+			return nil, translateError(err, "user")
+		}
+		for i := range users {
+			results[i].User = &users[i]
+		}
+		return results, nil
+	}
+
+	for start := 0; start < len(users); start += batchInsertSize {
+		end := min(start+batchInsertSize, len(users))
+		chunk := users[start:end]
+		if err := db.Create(&chunk).Error; err == nil {
+			for i := start; i < end; i++ {
+				results[i].User = &users[i]
+			}
+			continue
+		}
+
+		// The statement rolled back as a whole; find the rows that caused it
+		for i := start; i < end; i++ {
+			if err := db.Create(&users[i]).Error; err != nil {
+				results[i].Err = translateError(err, "user")
+				continue
+			}
+			results[i].User = &users[i]
+		}
+	}
+	return results, nil
+}
+
+// getUsersByID loads the users among ids with a single WHERE id IN query.
+// Missing IDs are simply absent from the result.
+func getUsersByID[T any](db *gorm.DB, ids []string) ([]T, error) {
+	users := make([]T, 0, len(ids))
+	if len(ids) == 0 {
+		return users, nil
+	}
+	if err := db.Where("id IN ?", ids).Find(&users).Error; err != nil {
+		return nil, translateError(err, "user")
+	}
+	return users, nil
+}
+
+// deleteUsers removes the users among ids and returns the IDs it deleted. An
+// atomic batch deletes nothing unless every ID exists.
+func deleteUsers(db *gorm.DB, table interface{}, ids []string, atomic bool) ([]string, error) {
+	deleted := make([]string, 0, len(ids))
+	if len(ids) == 0 {
+		return deleted, nil
+	}
+
+	err := db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(table).Where("id IN ?", ids).Pluck("id", &deleted).Error; err != nil {
+			return err
+		}
+		if atomic {
+			if missing := firstMissing(ids, deleted); missing != "" {
+				return NotFound("user", missing)
+			}
+		}
+		if len(deleted) == 0 {
+			return nil
+		}
+		return tx.Where("id IN ?", deleted).Delete(table).Error
+	})
+	if err != nil {
+		return nil, translateError(err, "user")
+	}
+	return deleted, nil
+}
+
+// firstMissing returns the first of ids that is not in found.
+func firstMissing(ids, found []string) string {
+	seen := make(map[string]bool, len(found))
+	for _, id := range found {
+		seen[id] = true
+	}
+	for _, id := range ids {
+		if !seen[id] {
+			return id
+		}
+	}
+	return ""
+}
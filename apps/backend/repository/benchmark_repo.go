@@ -0,0 +1,113 @@
+package repository
+
+import (
+	"time"
+
+	"github.com/theCompanyDream/id-trials/apps/backend/models"
+	"github.com/theCompanyDream/id-trials/apps/backend/models/stats"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type BenchmarkRepository struct {
+	DB *gorm.DB
+}
+
+func NewBenchmarkRepository(db *gorm.DB) *BenchmarkRepository {
+	return &BenchmarkRepository{DB: db}
+}
+
+// FlushStats asks Postgres to publish the pending statistics of the current
+// backend, so a snapshot taken right after a batch includes its own writes.
+// It must run on the same connection that performed the batch.
+func (r *BenchmarkRepository) FlushStats() error {
+	return r.DB.Exec("SELECT pg_stat_force_next_flush()").Error
+}
+
+// Snapshot reads the WAL, index and buffer counters for a single table.
+func (r *BenchmarkRepository) Snapshot(table string) (*stats.WriteStatsSnapshot, error) {
+	var snapshot stats.WriteStatsSnapshot
+
+	// Drop any cached statistics so the counters reflect the latest flush
+	if err := r.DB.Exec("SELECT pg_stat_clear_snapshot()").Error; err != nil {
+		return nil, err
+	}
+
+	err := r.DB.Raw(`
+		SELECT
+			w.wal_bytes::bigint AS wal_bytes,
+			w.wal_records,
+			w.wal_fpi,
+			COALESCE((
+				SELECT SUM(pg_relation_size(i.indexrelid))
+				FROM pg_stat_user_indexes i
+				WHERE i.relid = t.relid
+			), 0)::bigint / current_setting('block_size')::bigint AS index_pages,
+			COALESCE(t.idx_blks_read, 0) AS index_blocks_read,
+			COALESCE(t.idx_blks_hit, 0) AS index_blocks_hit,
+			COALESCE(t.heap_blks_read, 0) AS heap_blocks_read,
+			COALESCE(t.heap_blks_hit, 0) AS heap_blocks_hit
+		FROM pg_statio_user_tables t
+		CROSS JOIN pg_stat_wal w
+		WHERE t.schemaname = 'public' AND t.relname = ?
+	`, table).Scan(&snapshot).Error
+
+	return &snapshot, err
+}
+
+func (r *BenchmarkRepository) SaveWriteBenchmark(result *models.WriteBenchmark) error {
+	return r.DB.Create(result).Error
+}
+
+// SaveGenerationRun creates the run, or updates it once it has an ID.
+func (r *BenchmarkRepository) SaveGenerationRun(run *models.GenerationRun) error {
+	return r.DB.Save(run).Error
+}
+
+// UnfinishedGenerationRun returns the run with runID, or the latest run that
+// did not complete when runID is "latest".
+func (r *BenchmarkRepository) UnfinishedGenerationRun(runID string) (*models.GenerationRun, error) {
+	var run models.GenerationRun
+	query := r.DB.Where("status <> ?", models.GenerationCompleted)
+	if runID != "latest" {
+		query = query.Where("run_id = ?", runID)
+	}
+	if err := query.Order("timestamp DESC").First(&run).Error; err != nil {
+		return nil, translateError(err, "unfinished generation run")
+	}
+	return &run, nil
+}
+
+// GenerationCheckpoints returns the rows completed per ID type of a run.
+func (r *BenchmarkRepository) GenerationCheckpoints(runID string) (map[string]int64, error) {
+	var checkpoints []models.GenerationCheckpoint
+	if err := r.DB.Where("run_id = ?", runID).Find(&checkpoints).Error; err != nil {
+		return nil, err
+	}
+	completed := make(map[string]int64, len(checkpoints))
+	for _, checkpoint := range checkpoints {
+		completed[checkpoint.IDType] = checkpoint.Completed
+	}
+	return completed, nil
+}
+
+// SaveGenerationCheckpoint records that completed users of idType are written.
+func (r *BenchmarkRepository) SaveGenerationCheckpoint(runID, idType string, completed int64) error {
+	return r.DB.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "run_id"}, {Name: "id_type"}},
+		DoUpdates: clause.AssignmentColumns([]string{"completed", "updated_at"}),
+	}).Create(&models.GenerationCheckpoint{
+		RunID:     runID,
+		IDType:    idType,
+		Completed: completed,
+		UpdatedAt: time.Now(),
+	}).Error
+}
+
+// TableSize is the on-disk size of a table in bytes, including its indexes
+// and TOAST data.
+func (r *BenchmarkRepository) TableSize(table string) (int64, error) {
+	var size int64
+	err := r.DB.Raw("SELECT pg_total_relation_size(?::regclass)", table).Scan(&size).Error
+	return size, err
+}
@@ -0,0 +1,100 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"reflect"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/stdlib"
+	"gorm.io/gorm"
+	"gorm.io/gorm/schema"
+)
+
+// ErrCopyUnsupported is returned by CopyFrom on connections that are not pgx,
+// such as the SQLite database of the tests.
+var ErrCopyUnsupported = errors.New("COPY needs a pgx Postgres connection")
+
+// CopyRows turns a slice of models into the table, columns and values of a
+// COPY. Columns with a database default are left out when the first row does
+// not set them, so Postgres fills them in as it would for an INSERT.
+func CopyRows(db *gorm.DB, rows interface{}) (string, []string, [][]interface{}, error) {
+	stmt := &gorm.Statement{DB: db}
+	if err := stmt.Parse(rows); err != nil {
+		return "", nil, nil, err
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(rows))
+	if value.Kind() != reflect.Slice || value.Len() == 0 {
+		return stmt.Schema.Table, nil, nil, nil
+	}
+
+	ctx := context.Background()
+	var fields []*schema.Field
+	for _, field := range stmt.Schema.Fields {
+		if field.DBName == "" || !field.Creatable {
+			continue
+		}
+		if _, zero := field.ValueOf(ctx, reflect.Indirect(value.Index(0))); zero && field.HasDefaultValue {
+			continue
+		}
+		fields = append(fields, field)
+	}
+
+	columns := make([]string, len(fields))
+	for i, field := range fields {
+		columns[i] = field.DBName
+	}
+	data := make([][]interface{}, value.Len())
+	for i := range data {
+		row := reflect.Indirect(value.Index(i))
+		data[i] = make([]interface{}, len(fields))
+		for j, field := range fields {
+			data[i][j], _ = field.ValueOf(ctx, row)
+		}
+	}
+	return stmt.Schema.Table, columns, data, nil
+}
+
+// CopyFrom writes a slice of models with COPY FROM STDIN, which skips the
+// per-statement parse and bind work of INSERT and is the fastest way to load
+// large tables. It returns the number of rows copied.
+func CopyFrom(db *gorm.DB, rows interface{}) (int64, error) {
+	table, columns, data, err := CopyRows(db, rows)
+	if err != nil || len(data) == 0 {
+		return 0, err
+	}
+
+	ctx := db.Statement.Context
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	// A connection pinned with db.Connection may hold an open transaction, so
+	// copy on it rather than on a fresh one from the pool
+	conn, pinned := db.Statement.ConnPool.(*sql.Conn)
+	if !pinned {
+		sqlDB, err := db.DB()
+		if err != nil {
+			return 0, err
+		}
+		if conn, err = sqlDB.Conn(ctx); err != nil {
+			return 0, err
+		}
+		defer conn.Close()
+	}
+
+	var copied int64
+	err = conn.Raw(func(driverConn interface{}) error {
+		pgxConn, ok := driverConn.(*stdlib.Conn)
+		if !ok {
+			return ErrCopyUnsupported
+		}
+		copied, err = pgxConn.Conn().CopyFrom(ctx, pgx.Identifier{table}, columns, pgx.CopyFromRows(data))
+		return err
+	})
+	if err != nil {
+		return 0, translateError(err, table)
+	}
+	return copied, nil
+}
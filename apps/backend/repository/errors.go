@@ -0,0 +1,123 @@
+package repository
+
+import (
+	"database/sql/driver"
+	"errors"
+	"io"
+	"net"
+	"strings"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"gorm.io/gorm"
+)
+
+// ErrorKind classifies a DomainError so callers can map it to a response.
+type ErrorKind string
+
+const (
+	KindNotFound   ErrorKind = "not_found"
+	KindConflict   ErrorKind = "conflict"
+	KindInvalidID  ErrorKind = "invalid_id"
+	KindValidation ErrorKind = "validation"
+	// KindPreconditionFailed means a conditional write lost to a concurrent one
+	KindPreconditionFailed ErrorKind = "precondition_failed"
+)
+
+// DomainError is the error returned by repositories and controllers for
+// failures the client can act on. Fields holds per-field messages for
+// invalid IDs and validation failures.
+type DomainError struct {
+	Kind    ErrorKind
+	Message string
+	Fields  map[string]string
+	Err     error
+}
+
+// Sentinels for errors.Is; any DomainError of the same kind matches them.
+var (
+	ErrNotFound   = &DomainError{Kind: KindNotFound, Message: "not found"}
+	ErrConflict   = &DomainError{Kind: KindConflict, Message: "conflict"}
+	ErrInvalidID  = &DomainError{Kind: KindInvalidID, Message: "invalid id"}
+	ErrValidation = &DomainError{Kind: KindValidation, Message: "validation failed"}
+	// ErrPreconditionFailed is returned when If-Match or a stored version no longer matches
+	ErrPreconditionFailed = &DomainError{Kind: KindPreconditionFailed, Message: "precondition failed"}
+)
+
+func (e *DomainError) Error() string {
+	if e.Err != nil {
+		return e.Message + ": " + e.Err.Error()
+	}
+	return e.Message
+}
+
+func (e *DomainError) Unwrap() error {
+	return e.Err
+}
+
+func (e *DomainError) Is(target error) bool {
+	var other *DomainError
+	return errors.As(target, &other) && other.Kind == e.Kind
+}
+
+func NotFound(resource, id string) error {
+	if id == "" {
+		return &DomainError{Kind: KindNotFound, Message: resource + " not found"}
+	}
+	return &DomainError{Kind: KindNotFound, Message: resource + " " + id + " not found"}
+}
+
+func PreconditionFailed(resource, id string) error {
+	return &DomainError{Kind: KindPreconditionFailed, Message: resource + " " + id + " has been modified"}
+}
+
+func InvalidID(fields map[string]string) error {
+	return &DomainError{Kind: KindInvalidID, Message: "invalid id", Fields: fields}
+}
+
+func Validation(fields map[string]string) error {
+	return &DomainError{Kind: KindValidation, Message: "validation failed", Fields: fields}
+}
+
+// translateError converts driver errors into domain errors and passes
+// anything it does not recognise through unchanged.
+func translateError(err error, resource string) error {
+	var pgErr *pgconn.PgError
+
+	switch {
+	case err == nil:
+		return nil
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return &DomainError{Kind: KindNotFound, Message: resource + " not found", Err: err}
+	case errors.Is(err, gorm.ErrDuplicatedKey):
+		return &DomainError{Kind: KindConflict, Message: resource + " already exists", Err: err}
+	case errors.As(err, &pgErr) && pgErr.Code == "23505":
+		return &DomainError{Kind: KindConflict, Message: resource + " already exists", Err: err}
+	case errors.As(err, &pgErr) && pgErr.Code == "23503":
+		return &DomainError{Kind: KindConflict, Message: resource + " references a record that does not exist", Err: err}
+	}
+	return err
+}
+
+// IsTransient reports whether err is a failure that may succeed when retried:
+// a lost or refused connection, a serialization failure or deadlock, or a
+// server that is shutting down or out of connections.
+func IsTransient(err error) bool {
+	var pgErr *pgconn.PgError
+	var netErr net.Error
+
+	switch {
+	case err == nil:
+		return false
+	case errors.As(err, &pgErr):
+		return strings.HasPrefix(pgErr.Code, "08") || // connection exception
+			pgErr.Code == "40001" || // serialization_failure
+			pgErr.Code == "40P01" || // deadlock_detected
+			pgErr.Code == "53300" || // too_many_connections
+			strings.HasPrefix(pgErr.Code, "57P") // admin, crash or cannot-connect-now shutdown
+	case errors.Is(err, driver.ErrBadConn), errors.Is(err, io.ErrUnexpectedEOF), errors.Is(err, io.EOF):
+		return true
+	case errors.As(err, &netErr):
+		return true
+	}
+	return pgconn.SafeToRetry(err) || pgconn.Timeout(err)
+}
@@ -0,0 +1,204 @@
+package repository
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/callbacks"
+	"gorm.io/gorm/clause"
+)
+
+type queryPlansKey struct{}
+
+// CapturedPlan is the raw EXPLAIN output for one statement run by a repository.
+type CapturedPlan struct {
+	Operation string
+	Table     string
+	Query     string
+	Plan      string
+}
+
+// QueryPlanCollector gathers the plans of every statement run with its context,
+// and the time spent explaining them.
+type QueryPlanCollector struct {
+	mu       sync.Mutex
+	plans    []CapturedPlan
+	overhead time.Duration
+}
+
+func NewQueryPlanCollector() *QueryPlanCollector {
+	return &QueryPlanCollector{}
+}
+
+func (qc *QueryPlanCollector) add(plan CapturedPlan, took time.Duration) {
+	qc.mu.Lock()
+	defer qc.mu.Unlock()
+	qc.plans = append(qc.plans, plan)
+	qc.overhead += took
+}
+
+// Overhead returns the time spent explaining so far, which callers leave out
+// of the latency they measure. It is safe to call on a nil collector.
+func (qc *QueryPlanCollector) Overhead() time.Duration {
+	if qc == nil {
+		return 0
+	}
+	qc.mu.Lock()
+	defer qc.mu.Unlock()
+	return qc.overhead
+}
+
+// Plans returns the plans captured so far. It is safe to call on a nil collector.
+func (qc *QueryPlanCollector) Plans() []CapturedPlan {
+	if qc == nil {
+		return nil
+	}
+	qc.mu.Lock()
+	defer qc.mu.Unlock()
+	return append([]CapturedPlan(nil), qc.plans...)
+}
+
+// WithQueryPlans marks ctx so repository queries run with it are also explained.
+func WithQueryPlans(ctx context.Context, collector *QueryPlanCollector) context.Context {
+	return context.WithValue(ctx, queryPlansKey{}, collector)
+}
+
+func queryPlansFromContext(ctx context.Context) *QueryPlanCollector {
+	if ctx == nil {
+		return nil
+	}
+	collector, _ := ctx.Value(queryPlansKey{}).(*QueryPlanCollector)
+	return collector
+}
+
+// ExplainPlugin explains queries and updates when their context carries a
+// QueryPlanCollector. It is a no-op otherwise. Statements are run under
+// EXPLAIN (ANALYZE, BUFFERS, FORMAT JSON). Queries are re-run after the fact;
+// updates are analyzed before they run, in a savepoint that is rolled back,
+// so the plan measures the same write and the write itself happens once.
+type ExplainPlugin struct{}
+
+func (ExplainPlugin) Name() string {
+	return "explain"
+}
+
+func (ExplainPlugin) Initialize(db *gorm.DB) error {
+	if err := db.Callback().Query().After("gorm:query").Register("explain:query", explainQuery); err != nil {
+		return err
+	}
+	return db.Callback().Update().Before("gorm:update").Register("explain:update", explainUpdate)
+}
+
+const analyzePrefix = "EXPLAIN (ANALYZE, BUFFERS, FORMAT JSON) "
+
+func explainQuery(db *gorm.DB) {
+	// Reads are side effect free, so the plan can run straight on the pool
+	explain(db, "query", func(query string) (string, error) {
+		return scanPlan(db.Statement.Context, db.Statement.ConnPool, query, db.Statement.Vars)
+	})
+}
+
+func explainUpdate(db *gorm.DB) {
+	if queryPlansFromContext(db.Statement.Context) == nil || !buildUpdate(db) {
+		return
+	}
+	explain(db, "update", func(query string) (string, error) {
+		return analyzeUpdate(db, query)
+	})
+}
+
+// buildUpdate builds the UPDATE of db the way gorm:update would, which then
+// runs it as built. It reports false when there is nothing to explain: no
+// columns to set, or no WHERE clause, which gorm refuses anyway.
+func buildUpdate(db *gorm.DB) bool {
+	stmt := db.Statement
+	if db.Error != nil {
+		return false
+	}
+	if stmt.SQL.Len() > 0 {
+		return true
+	}
+	if stmt.Schema != nil {
+		for _, c := range stmt.Schema.UpdateClauses {
+			stmt.AddClause(c)
+		}
+	}
+	stmt.AddClauseIfNotExists(clause.Update{})
+	if _, ok := stmt.Clauses["SET"]; !ok {
+		set := callbacks.ConvertToAssignments(stmt)
+		if len(set) == 0 {
+			return false
+		}
+		defer delete(stmt.Clauses, "SET")
+		stmt.AddClause(set)
+	}
+	if _, ok := stmt.Clauses["WHERE"]; !ok && !db.AllowGlobalUpdate {
+		return false
+	}
+	stmt.Build(stmt.BuildClauses...)
+	return true
+}
+
+// analyzeUpdate runs query in a savepoint of the update's transaction, or in a
+// transaction of its own when there is none, and rolls it back.
+func analyzeUpdate(db *gorm.DB, query string) (string, error) {
+	ctx, pool := db.Statement.Context, db.Statement.ConnPool
+	if _, inTx := pool.(gorm.TxCommitter); inTx {
+		if _, err := pool.ExecContext(ctx, "SAVEPOINT explain_update"); err != nil {
+			return "", err
+		}
+		plan, err := scanPlan(ctx, pool, query, db.Statement.Vars)
+		if _, rbErr := pool.ExecContext(ctx, "ROLLBACK TO SAVEPOINT explain_update"); rbErr != nil {
+			return "", rbErr
+		}
+		if _, relErr := pool.ExecContext(ctx, "RELEASE SAVEPOINT explain_update"); relErr != nil {
+			return "", relErr
+		}
+		return plan, err
+	}
+
+	var tx gorm.ConnPool
+	switch beginner := pool.(type) {
+	case gorm.TxBeginner:
+		sqlTx, err := beginner.BeginTx(ctx, nil)
+		if err != nil {
+			return "", err
+		}
+		tx = sqlTx
+	case gorm.ConnPoolBeginner:
+		poolTx, err := beginner.BeginTx(ctx, nil)
+		if err != nil {
+			return "", err
+		}
+		tx = poolTx
+	default:
+		return "", gorm.ErrInvalidTransaction
+	}
+	defer tx.(gorm.TxCommitter).Rollback()
+	return scanPlan(ctx, tx, query, db.Statement.Vars)
+}
+
+func scanPlan(ctx context.Context, pool gorm.ConnPool, query string, vars []interface{}) (string, error) {
+	var plan string
+	err := pool.QueryRowContext(ctx, query, vars...).Scan(&plan)
+	return plan, err
+}
+
+// explain runs the statement of db under EXPLAIN ANALYZE with run and adds
+// its plan to the collector of db's context.
+func explain(db *gorm.DB, operation string, run func(query string) (string, error)) {
+	collector := queryPlansFromContext(db.Statement.Context)
+	if collector == nil || db.Error != nil || db.Statement.SQL.Len() == 0 {
+		return
+	}
+
+	start := time.Now()
+	plan, err := run(analyzePrefix + db.Statement.SQL.String())
+	if err != nil {
+		db.Logger.Warn(db.Statement.Context, "explain failed: %v", err)
+		return
+	}
+	collector.add(CapturedPlan{Operation: operation, Table: db.Statement.Table, Query: db.Statement.SQL.String(), Plan: plan}, time.Since(start))
+}
@@ -0,0 +1,38 @@
+package repository
+
+import (
+	"gorm.io/gorm"
+
+	model "github.com/theCompanyDream/id-trials/apps/backend/models"
+)
+
+// exportPageSize is the number of rows each keyset query of an export loads.
+const exportPageSize = 1000
+
+// exportUsers walks the users matching search in ID order with a keyset scan
+// (WHERE id > last ORDER BY id LIMIT n), so memory stays flat however large
+// the table is, and hands each user to emit. key returns the ID of a row in
+// its column type, which keeps integer IDs ordered numerically.
+func exportUsers[T any](db *gorm.DB, search string, key func(*T) interface{}, toDTO func(*T) *model.UserDTO, emit func(*model.UserDTO) error) error {
+	var after interface{}
+	for {
+		page := make([]T, 0, exportPageSize)
+		query := ApplySearch(db.Model(new(T)), search)
+		if after != nil {
+			query = query.Where("id > ?", after)
+		}
+		if err := query.Order("id").Limit(exportPageSize).Find(&page).Error; err != nil {
+			return translateError(err, "user")
+		}
+
+		for i := range page {
+			if err := emit(toDTO(&page[i])); err != nil {
+				return err
+			}
+		}
+		if len(page) < exportPageSize {
+			return nil
+		}
+		after = key(&page[len(page)-1])
+	}
+}
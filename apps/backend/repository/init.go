@@ -1,34 +1,56 @@
 package repository
 
 import (
+	"database/sql"
 	"fmt"
-	"os"
 
+	"github.com/theCompanyDream/id-trials/apps/backend/config"
 	model "github.com/theCompanyDream/id-trials/apps/backend/models"
 	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
 	"gorm.io/gorm/logger"
 )
 
-// GetPostgresConnectionString returns a PostgreSQL connection string.
-// It first checks for POSTGRES_URL and falls back to constructing the string.
-func GetPostgresConnectionString() string {
-	// More explicit connection string for Docker/internal network
-	return fmt.Sprintf("host=%s port=%s user=%s password=%s dbname=%s sslmode=disable TimeZone=UTC",
-		os.Getenv("DATABASE_HOST"),
-		os.Getenv("DATABASE_PORT"),
-		os.Getenv("DATABASE_USERNAME"),
-		os.Getenv("DATABASE_PASSWORD"),
-		os.Getenv("DATABASE_NAME"))
+// searchMode and partitionedStorage are the repository settings of the
+// configuration, set once at startup by Configure.
+var (
+	searchMode         = SearchModeILike
+	partitionedStorage bool
+)
+
+// Configure applies the search and storage settings of cfg to every
+// repository.
+func Configure(cfg *config.Config) {
+	searchMode = cfg.Search.Mode
+	partitionedStorage = cfg.Storage.Partitioned
 }
 
-func InitDB() (*gorm.DB, error) {
-	var err error
-	connectStr := GetPostgresConnectionString()
-	fmt.Println("Connecting to:", connectStr)
+// InitDB configures the repositories with cfg, connects to its database and
+// checks that the schema is migrated.
+func InitDB(cfg *config.Config) (*gorm.DB, error) {
+	Configure(cfg)
+	db, err := Connect(cfg.Database)
+	if err != nil {
+		return nil, err
+	}
+
+	// Refuse to run against a schema the migrations did not produce
+	if err := CheckSchema(db); err != nil {
+		return nil, err
+	}
+
+	fmt.Println("Database connection successful")
+	return db, nil
+}
+
+// Connect opens and pings the database of cfg without checking the schema,
+// for the migrate command.
+func Connect(cfg config.Database) (*gorm.DB, error) {
+	dsn := cfg.DSN()
+	fmt.Println("Connecting to:", cfg.Redacted().DSN())
 
 	// Add more verbose logging and configuration
-	db, err := gorm.Open(postgres.Open(connectStr), &gorm.Config{
+	db, err := gorm.Open(postgres.Open(dsn), &gorm.Config{
 		// Add additional configurations
 		Logger: logger.Default.LogMode(logger.Info), // Enable detailed logging
 	})
@@ -41,32 +63,47 @@ func InitDB() (*gorm.DB, error) {
 	if err != nil {
 		return nil, fmt.Errorf("failed to get database: %v", err)
 	}
+	ConfigurePool(sqlDB, cfg)
 
 	// Ping the database
 	if err := sqlDB.Ping(); err != nil {
 		return nil, fmt.Errorf("failed to ping database: %v", err)
 	}
 
-	// Auto migrate with more detailed error handling
-	if err := db.AutoMigrate(
-		&model.UserUlid{},
-		&model.UserCUID{},
-		&model.UserKSUID{},
-		&model.UserNanoID{},
-		&model.UserSnowflake{},
-		&model.RouteMetric{},
-		&model.UserUUID{}); err != nil {
-		// Log the error as a warning and continue
-		fmt.Printf("Warning: Failed to auto migrate: %v", err)
+	if err := db.Use(ExplainPlugin{}); err != nil {
+		return nil, fmt.Errorf("failed to register explain plugin: %v", err)
 	}
 
-	fmt.Println("Database connection successful")
 	return db, nil
 }
 
-func ServerlessInitDB() (*gorm.DB, error) {
+// ConfigurePool applies the connection pool settings of cfg.
+func ConfigurePool(sqlDB *sql.DB, cfg config.Database) {
+	sqlDB.SetMaxOpenConns(cfg.MaxOpenConns)
+	sqlDB.SetMaxIdleConns(cfg.MaxIdleConns)
+	sqlDB.SetConnMaxLifetime(cfg.ConnMaxLifetime)
+	sqlDB.SetConnMaxIdleTime(cfg.ConnMaxIdleTime)
+}
+
+// MetricModels are the tables of recorded measurements: request metrics,
+// query plans, benchmarks and generation runs.
+func MetricModels() []interface{} {
+	return []interface{}{
+		&model.RouteMetric{},
+		&model.QueryPlan{},
+		&model.WriteBenchmark{},
+		&model.GenerationCheckpoint{},
+		&model.GenerationRun{},
+		&model.PoolStat{},
+	}
+}
+
+// ServerlessInitDB is InitDB for the serverless handler, which cannot keep
+// prepared statements between invocations.
+func ServerlessInitDB(cfg *config.Config) (*gorm.DB, error) {
 	var err error
-	connectStr := os.Getenv("POSTGRES_URL")
+	Configure(cfg)
+	connectStr := cfg.Database.DSN()
 
 	db, err := gorm.Open(postgres.Open(connectStr), &gorm.Config{
 		Logger: logger.Default.LogMode(logger.Info),
@@ -77,5 +114,19 @@ func ServerlessInitDB() (*gorm.DB, error) {
 		return nil, fmt.Errorf("failed to connect to database: %v", err)
 	}
 
+	sqlDB, err := db.DB()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get database: %v", err)
+	}
+	ConfigurePool(sqlDB, cfg.Database)
+
+	if err := db.Use(ExplainPlugin{}); err != nil {
+		return nil, fmt.Errorf("failed to register explain plugin: %v", err)
+	}
+
+	if err := CheckSchema(db); err != nil {
+		return nil, err
+	}
+
 	return db, nil
 }
@@ -0,0 +1,32 @@
+package repository
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+
+	model "github.com/theCompanyDream/id-trials/apps/backend/models"
+)
+
+// Generic repository interface for the orders child tables
+type IOrderRepository[T any] interface {
+	WithContext(ctx context.Context) IOrderRepository[T]
+	GetOrder(id string) (*T, error)
+	GetOrdersByUser(userId string, page, limit int) (*model.OrderPaging, error)
+	CreateOrder(requestedOrder T) (*T, error)
+	UpdateOrder(requestedOrder T) (*T, error)
+	DeleteOrder(id string) error
+}
+
+// requireUser returns NotFound unless table holds a user with id, so that an
+// unknown user is told apart from one without orders.
+func requireUser(db *gorm.DB, table string, id string) error {
+	var count int64
+	if err := db.Table(table).Where("id = ?", id).Count(&count).Error; err != nil {
+		return translateError(err, "user")
+	}
+	if count == 0 {
+		return NotFound("user", id)
+	}
+	return nil
+}
@@ -1,12 +1,68 @@
 package repository
 
-import model "github.com/theCompanyDream/id-trials/apps/backend/models"
+import (
+	"context"
+	"fmt"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	model "github.com/theCompanyDream/id-trials/apps/backend/models"
+)
 
 // Generic repository interface
 type IRepository[T any] interface {
+	WithContext(ctx context.Context) IRepository[T]
 	GetUser(hashId string) (*T, error)
 	GetUsers(search string, page, limit int) (*model.UserPaging, error) // Made generic
 	CreateUser(requestedUser T) (*T, error)
-	UpdateUser(requestedUser T) (*T, error)
+	UpdateUser(requestedUser T) (*T, error) // Replaces every user column; a non-zero Version makes the write conditional
 	DeleteUser(id string) error
+
+	CreateUsers(requestedUsers []T, atomic bool) ([]BatchResult[T], error) // Multi-row INSERT; an atomic batch fails as a whole
+	GetUsersByID(ids []string) ([]T, error)                                // Users found among ids, in no particular order
+	DeleteUsers(ids []string, atomic bool) ([]string, error)               // Returns the deleted IDs; an atomic batch needs every ID to exist
+
+	ExportUsers(search string, emit func(*model.UserDTO) error) error // Streams every matching user in ID order
+}
+
+// replaceUser writes every column of base to the user with id, including empty
+// ones, and bumps its version. When base.Version is set the write only applies
+// if the stored version still matches, which makes read-modify-write safe. The
+// written row is returned into user by the UPDATE itself, so a concurrent
+// write cannot slip in between.
+func replaceUser(db *gorm.DB, user interface{}, id interface{}, base *model.UserBase) error {
+	if base == nil {
+		base = &model.UserBase{}
+	}
+
+	query := db.Model(user).Clauses(clause.Returning{}).Where("id = ?", id)
+	if base.Version != 0 {
+		query = query.Where("version = ?", base.Version)
+	}
+
+	result := query.Updates(map[string]interface{}{
+		"user_name":  base.UserName,
+		"first_name": base.FirstName,
+		"last_name":  base.LastName,
+		"email":      base.Email,
+		"department": base.Department,
+		"version":    gorm.Expr("version + 1"),
+	})
+	if result.Error != nil {
+		return translateError(result.Error, "user")
+	}
+	if result.RowsAffected > 0 {
+		return nil
+	}
+
+	// Nothing matched: either the user is gone or its version moved on
+	var count int64
+	if err := db.Model(user).Where("id = ?", id).Count(&count).Error; err != nil {
+		return translateError(err, "user")
+	}
+	if count == 0 {
+		return NotFound("user", fmt.Sprint(id))
+	}
+	return PreconditionFailed("user", fmt.Sprint(id))
 }
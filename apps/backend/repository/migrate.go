@@ -0,0 +1,275 @@
+package repository
+
+import (
+	"crypto/sha256"
+	"embed"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/fs"
+	"regexp"
+	"sort"
+	"strconv"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+//go:embed migrations/*.sql
+var migrationFiles embed.FS
+
+// Migrations are the schema migrations built into the binary.
+func Migrations() fs.FS {
+	files, err := fs.Sub(migrationFiles, "migrations")
+	if err != nil {
+		panic(err)
+	}
+	return files
+}
+
+// ErrSchemaMismatch is returned when the database is not at the schema the
+// migrations describe: migrations are pending, were edited after they ran, or
+// ran from a newer build.
+var ErrSchemaMismatch = errors.New("database schema does not match the migrations")
+
+// migrationFile matches NNNN_name.up.sql and NNNN_name.down.sql.
+var migrationFile = regexp.MustCompile(`^(\d+)_(\w+)\.(up|down)\.sql$`)
+
+// Migration is one versioned schema change.
+type Migration struct {
+	Version  int64
+	Name     string
+	Up       string
+	Down     string
+	Checksum string // SHA-256 of Up, to notice a migration edited after it ran
+}
+
+// AppliedMigration is a row of schema_migrations.
+type AppliedMigration struct {
+	Version   int64     `gorm:"primaryKey;autoIncrement:false"`
+	Name      string    `gorm:"type:varchar(255);not null"`
+	Checksum  string    `gorm:"type:varchar(64);not null"`
+	AppliedAt time.Time `gorm:"not null"`
+}
+
+func (AppliedMigration) TableName() string {
+	return "schema_migrations"
+}
+
+// MigrationState is a migration and whether it has been applied.
+type MigrationState struct {
+	Version   int64
+	Name      string
+	AppliedAt *time.Time
+	Modified  bool // Applied with a different checksum than the file has now
+	Unknown   bool // Applied, but no file has its version
+}
+
+// Migrator applies and rolls back the migrations of a directory, recording
+// each applied version in schema_migrations.
+type Migrator struct {
+	db         *gorm.DB
+	migrations []Migration
+}
+
+// NewMigrator reads the migrations in files, which holds pairs of
+// NNNN_name.up.sql and NNNN_name.down.sql.
+func NewMigrator(db *gorm.DB, files fs.FS) (*Migrator, error) {
+	entries, err := fs.ReadDir(files, ".")
+	if err != nil {
+		return nil, err
+	}
+
+	byVersion := map[int64]*Migration{}
+	for _, entry := range entries {
+		match := migrationFile.FindStringSubmatch(entry.Name())
+		if entry.IsDir() || match == nil {
+			continue
+		}
+		version, err := strconv.ParseInt(match[1], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("migration %s: %w", entry.Name(), err)
+		}
+		sql, err := fs.ReadFile(files, entry.Name())
+		if err != nil {
+			return nil, err
+		}
+
+		migration, ok := byVersion[version]
+		if !ok {
+			migration = &Migration{Version: version, Name: match[2]}
+			byVersion[version] = migration
+		} else if migration.Name != match[2] {
+			return nil, fmt.Errorf("migration %d is named both %s and %s", version, migration.Name, match[2])
+		}
+		if match[3] == "up" {
+			migration.Up = string(sql)
+		} else {
+			migration.Down = string(sql)
+		}
+	}
+
+	migrations := make([]Migration, 0, len(byVersion))
+	for _, migration := range byVersion {
+		if migration.Up == "" || migration.Down == "" {
+			return nil, fmt.Errorf("migration %04d_%s needs both an up and a down file", migration.Version, migration.Name)
+		}
+		sum := sha256.Sum256([]byte(migration.Up))
+		migration.Checksum = hex.EncodeToString(sum[:])
+		migrations = append(migrations, *migration)
+	}
+	sort.Slice(migrations, func(i, j int) bool { return migrations[i].Version < migrations[j].Version })
+
+	return &Migrator{db: db, migrations: migrations}, nil
+}
+
+// applied returns the rows of schema_migrations by version, creating the
+// table first when create is set.
+func (m *Migrator) applied(create bool) (map[int64]AppliedMigration, error) {
+	if !m.db.Migrator().HasTable(&AppliedMigration{}) {
+		if !create {
+			return map[int64]AppliedMigration{}, nil
+		}
+		// Plain SQL rather than AutoMigrate, so the table is the same on every dialect
+		if err := m.db.Exec(`CREATE TABLE IF NOT EXISTS schema_migrations (
+			version BIGINT PRIMARY KEY,
+			name VARCHAR(255) NOT NULL,
+			checksum VARCHAR(64) NOT NULL,
+			applied_at TIMESTAMP NOT NULL
+		)`).Error; err != nil {
+			return nil, fmt.Errorf("create schema_migrations: %w", err)
+		}
+	}
+
+	var rows []AppliedMigration
+	if err := m.db.Order("version").Find(&rows).Error; err != nil {
+		return nil, err
+	}
+	applied := make(map[int64]AppliedMigration, len(rows))
+	for _, row := range rows {
+		applied[row.Version] = row
+	}
+	return applied, nil
+}
+
+// Up applies up to steps pending migrations in version order, each in its own
+// transaction, or all of them when steps is 0 or less.
+func (m *Migrator) Up(steps int) ([]Migration, error) {
+	applied, err := m.applied(true)
+	if err != nil {
+		return nil, err
+	}
+
+	var done []Migration
+	for _, migration := range m.migrations {
+		if _, ok := applied[migration.Version]; ok {
+			continue
+		}
+		if steps > 0 && len(done) == steps {
+			break
+		}
+		err := m.db.Transaction(func(tx *gorm.DB) error {
+			if err := tx.Exec(migration.Up).Error; err != nil {
+				return err
+			}
+			return tx.Create(&AppliedMigration{
+				Version:   migration.Version,
+				Name:      migration.Name,
+				Checksum:  migration.Checksum,
+				AppliedAt: time.Now().UTC(),
+			}).Error
+		})
+		if err != nil {
+			return done, fmt.Errorf("migration %04d_%s: %w", migration.Version, migration.Name, err)
+		}
+		done = append(done, migration)
+	}
+	return done, nil
+}
+
+// Down rolls back up to steps applied migrations, newest first, or all of them
+// when steps is 0 or less.
+func (m *Migrator) Down(steps int) ([]Migration, error) {
+	applied, err := m.applied(false)
+	if err != nil {
+		return nil, err
+	}
+
+	var done []Migration
+	for i := len(m.migrations) - 1; i >= 0; i-- {
+		migration := m.migrations[i]
+		if _, ok := applied[migration.Version]; !ok {
+			continue
+		}
+		if steps > 0 && len(done) == steps {
+			break
+		}
+		err := m.db.Transaction(func(tx *gorm.DB) error {
+			if err := tx.Exec(migration.Down).Error; err != nil {
+				return err
+			}
+			return tx.Delete(&AppliedMigration{}, migration.Version).Error
+		})
+		if err != nil {
+			return done, fmt.Errorf("roll back migration %04d_%s: %w", migration.Version, migration.Name, err)
+		}
+		done = append(done, migration)
+	}
+	return done, nil
+}
+
+// Status lists every migration, and any applied version without a file, in
+// version order.
+func (m *Migrator) Status() ([]MigrationState, error) {
+	applied, err := m.applied(false)
+	if err != nil {
+		return nil, err
+	}
+
+	states := make([]MigrationState, 0, len(m.migrations))
+	for _, migration := range m.migrations {
+		state := MigrationState{Version: migration.Version, Name: migration.Name}
+		if row, ok := applied[migration.Version]; ok {
+			appliedAt := row.AppliedAt
+			state.AppliedAt = &appliedAt
+			state.Modified = row.Checksum != migration.Checksum
+			delete(applied, migration.Version)
+		}
+		states = append(states, state)
+	}
+	for _, row := range applied {
+		appliedAt := row.AppliedAt
+		states = append(states, MigrationState{Version: row.Version, Name: row.Name, AppliedAt: &appliedAt, Unknown: true})
+	}
+	sort.Slice(states, func(i, j int) bool { return states[i].Version < states[j].Version })
+	return states, nil
+}
+
+// Check returns ErrSchemaMismatch, naming the first difference, unless every
+// migration has been applied unchanged and nothing else has.
+func (m *Migrator) Check() error {
+	states, err := m.Status()
+	if err != nil {
+		return err
+	}
+	for _, state := range states {
+		switch {
+		case state.Unknown:
+			return fmt.Errorf("%w: migration %04d_%s is applied but unknown to this build", ErrSchemaMismatch, state.Version, state.Name)
+		case state.AppliedAt == nil:
+			return fmt.Errorf("%w: migration %04d_%s is pending, run `migrate up`", ErrSchemaMismatch, state.Version, state.Name)
+		case state.Modified:
+			return fmt.Errorf("%w: migration %04d_%s changed after it was applied", ErrSchemaMismatch, state.Version, state.Name)
+		}
+	}
+	return nil
+}
+
+// CheckSchema checks db against the migrations built into the binary.
+func CheckSchema(db *gorm.DB) error {
+	migrator, err := NewMigrator(db, Migrations())
+	if err != nil {
+		return err
+	}
+	return migrator.Check()
+}
@@ -0,0 +1,2 @@
+-- CASCADE also drops anything else built on pg_trgm
+DROP EXTENSION IF EXISTS pg_trgm CASCADE;
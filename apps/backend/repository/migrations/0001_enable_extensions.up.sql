@@ -0,0 +1,2 @@
+-- pg_trgm backs the GIN indexes of SEARCH_MODE=trigram
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
@@ -0,0 +1,6 @@
+DROP TABLE IF EXISTS users_snowflake;
+DROP TABLE IF EXISTS users_nanoid;
+DROP TABLE IF EXISTS users_cuid;
+DROP TABLE IF EXISTS users_uuid;
+DROP TABLE IF EXISTS users_ksuid;
+DROP TABLE IF EXISTS users_ulid;
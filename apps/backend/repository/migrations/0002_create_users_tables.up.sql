@@ -0,0 +1,71 @@
+-- One users table per ID type; only the type of the primary key differs.
+-- IF NOT EXISTS adopts tables created by earlier AutoMigrate releases, and the
+-- ADD COLUMNs at the end bring them up to date.
+
+CREATE TABLE IF NOT EXISTS users_ulid (
+    id          varchar(26) PRIMARY KEY,
+    user_name   varchar(25) NOT NULL,
+    first_name  varchar(40) NOT NULL,
+    last_name   varchar(40) NOT NULL,
+    email       varchar(40) NOT NULL,
+    department  varchar(25),
+    version     bigint NOT NULL DEFAULT 1
+);
+
+CREATE TABLE IF NOT EXISTS users_ksuid (
+    id          varchar(27) PRIMARY KEY,
+    user_name   varchar(25) NOT NULL,
+    first_name  varchar(40) NOT NULL,
+    last_name   varchar(40) NOT NULL,
+    email       varchar(40) NOT NULL,
+    department  varchar(25),
+    version     bigint NOT NULL DEFAULT 1
+);
+
+CREATE TABLE IF NOT EXISTS users_uuid (
+    id          varchar(36) PRIMARY KEY,
+    user_name   varchar(25) NOT NULL,
+    first_name  varchar(40) NOT NULL,
+    last_name   varchar(40) NOT NULL,
+    email       varchar(40) NOT NULL,
+    department  varchar(25),
+    version     bigint NOT NULL DEFAULT 1
+);
+
+CREATE TABLE IF NOT EXISTS users_cuid (
+    id          varchar(25) PRIMARY KEY,
+    user_name   varchar(25) NOT NULL,
+    first_name  varchar(40) NOT NULL,
+    last_name   varchar(40) NOT NULL,
+    email       varchar(40) NOT NULL,
+    department  varchar(25),
+    version     bigint NOT NULL DEFAULT 1
+);
+
+CREATE TABLE IF NOT EXISTS users_nanoid (
+    id          varchar(27) PRIMARY KEY,
+    user_name   varchar(25) NOT NULL,
+    first_name  varchar(40) NOT NULL,
+    last_name   varchar(40) NOT NULL,
+    email       varchar(40) NOT NULL,
+    department  varchar(25),
+    version     bigint NOT NULL DEFAULT 1
+);
+
+CREATE TABLE IF NOT EXISTS users_snowflake (
+    id          bigint PRIMARY KEY,
+    user_name   varchar(25) NOT NULL,
+    first_name  varchar(40) NOT NULL,
+    last_name   varchar(40) NOT NULL,
+    email       varchar(40) NOT NULL,
+    department  varchar(25),
+    version     bigint NOT NULL DEFAULT 1
+);
+
+-- Columns added since the AutoMigrate releases
+ALTER TABLE users_ulid ADD COLUMN IF NOT EXISTS version bigint NOT NULL DEFAULT 1;
+ALTER TABLE users_ksuid ADD COLUMN IF NOT EXISTS version bigint NOT NULL DEFAULT 1;
+ALTER TABLE users_uuid ADD COLUMN IF NOT EXISTS version bigint NOT NULL DEFAULT 1;
+ALTER TABLE users_cuid ADD COLUMN IF NOT EXISTS version bigint NOT NULL DEFAULT 1;
+ALTER TABLE users_nanoid ADD COLUMN IF NOT EXISTS version bigint NOT NULL DEFAULT 1;
+ALTER TABLE users_snowflake ADD COLUMN IF NOT EXISTS version bigint NOT NULL DEFAULT 1;
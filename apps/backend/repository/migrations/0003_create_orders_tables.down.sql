@@ -0,0 +1,6 @@
+DROP TABLE IF EXISTS orders_snowflake;
+DROP TABLE IF EXISTS orders_nanoid;
+DROP TABLE IF EXISTS orders_cuid;
+DROP TABLE IF EXISTS orders_uuid;
+DROP TABLE IF EXISTS orders_ksuid;
+DROP TABLE IF EXISTS orders_ulid;
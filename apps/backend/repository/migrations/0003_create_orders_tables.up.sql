@@ -0,0 +1,73 @@
+-- Orders reference their users table, so deleting a user deletes its orders.
+
+CREATE TABLE IF NOT EXISTS orders_ulid (
+    id           varchar(26) PRIMARY KEY,
+    user_id      varchar(26) NOT NULL,
+    product      varchar(60) NOT NULL,
+    quantity     bigint NOT NULL DEFAULT 1,
+    total_cents  bigint NOT NULL,
+    status       varchar(20) NOT NULL,
+    created_at   timestamptz NOT NULL,
+    CONSTRAINT fk_orders_ulid_user FOREIGN KEY (user_id) REFERENCES users_ulid (id) ON DELETE CASCADE
+);
+CREATE INDEX IF NOT EXISTS idx_orders_ulid_user_id ON orders_ulid (user_id);
+
+CREATE TABLE IF NOT EXISTS orders_ksuid (
+    id           varchar(27) PRIMARY KEY,
+    user_id      varchar(27) NOT NULL,
+    product      varchar(60) NOT NULL,
+    quantity     bigint NOT NULL DEFAULT 1,
+    total_cents  bigint NOT NULL,
+    status       varchar(20) NOT NULL,
+    created_at   timestamptz NOT NULL,
+    CONSTRAINT fk_orders_ksuid_user FOREIGN KEY (user_id) REFERENCES users_ksuid (id) ON DELETE CASCADE
+);
+CREATE INDEX IF NOT EXISTS idx_orders_ksuid_user_id ON orders_ksuid (user_id);
+
+CREATE TABLE IF NOT EXISTS orders_uuid (
+    id           varchar(36) PRIMARY KEY,
+    user_id      varchar(36) NOT NULL,
+    product      varchar(60) NOT NULL,
+    quantity     bigint NOT NULL DEFAULT 1,
+    total_cents  bigint NOT NULL,
+    status       varchar(20) NOT NULL,
+    created_at   timestamptz NOT NULL,
+    CONSTRAINT fk_orders_uuid_user FOREIGN KEY (user_id) REFERENCES users_uuid (id) ON DELETE CASCADE
+);
+CREATE INDEX IF NOT EXISTS idx_orders_uuid_user_id ON orders_uuid (user_id);
+
+CREATE TABLE IF NOT EXISTS orders_cuid (
+    id           varchar(25) PRIMARY KEY,
+    user_id      varchar(25) NOT NULL,
+    product      varchar(60) NOT NULL,
+    quantity     bigint NOT NULL DEFAULT 1,
+    total_cents  bigint NOT NULL,
+    status       varchar(20) NOT NULL,
+    created_at   timestamptz NOT NULL,
+    CONSTRAINT fk_orders_cuid_user FOREIGN KEY (user_id) REFERENCES users_cuid (id) ON DELETE CASCADE
+);
+CREATE INDEX IF NOT EXISTS idx_orders_cuid_user_id ON orders_cuid (user_id);
+
+CREATE TABLE IF NOT EXISTS orders_nanoid (
+    id           varchar(27) PRIMARY KEY,
+    user_id      varchar(27) NOT NULL,
+    product      varchar(60) NOT NULL,
+    quantity     bigint NOT NULL DEFAULT 1,
+    total_cents  bigint NOT NULL,
+    status       varchar(20) NOT NULL,
+    created_at   timestamptz NOT NULL,
+    CONSTRAINT fk_orders_nanoid_user FOREIGN KEY (user_id) REFERENCES users_nanoid (id) ON DELETE CASCADE
+);
+CREATE INDEX IF NOT EXISTS idx_orders_nanoid_user_id ON orders_nanoid (user_id);
+
+CREATE TABLE IF NOT EXISTS orders_snowflake (
+    id           bigint PRIMARY KEY,
+    user_id      bigint NOT NULL,
+    product      varchar(60) NOT NULL,
+    quantity     bigint NOT NULL DEFAULT 1,
+    total_cents  bigint NOT NULL,
+    status       varchar(20) NOT NULL,
+    created_at   timestamptz NOT NULL,
+    CONSTRAINT fk_orders_snowflake_user FOREIGN KEY (user_id) REFERENCES users_snowflake (id) ON DELETE CASCADE
+);
+CREATE INDEX IF NOT EXISTS idx_orders_snowflake_user_id ON orders_snowflake (user_id);
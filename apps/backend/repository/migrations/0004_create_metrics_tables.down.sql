@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS generation_checkpoints;
+DROP TABLE IF EXISTS generation_runs;
+DROP TABLE IF EXISTS write_benchmarks;
+DROP TABLE IF EXISTS query_plans;
+DROP TABLE IF EXISTS route_metrics;
@@ -0,0 +1,110 @@
+-- Request metrics recorded by middleware.MetricsMiddleware. IF NOT EXISTS
+-- adopts the tables of earlier AutoMigrate releases, and the ADD COLUMNs after
+-- each table bring them up to date.
+CREATE TABLE IF NOT EXISTS route_metrics (
+    id                 bigserial PRIMARY KEY,
+    route_path         varchar(255) NOT NULL,
+    http_method        varchar(10) NOT NULL,
+    id_type            varchar(20) NOT NULL,
+    storage_mode       varchar(20) NOT NULL DEFAULT 'heap',
+    search_mode        varchar(20) NOT NULL DEFAULT 'none',
+    conditional        boolean NOT NULL DEFAULT false,
+    total_duration     decimal NOT NULL,
+    db_query_duration  decimal NOT NULL,
+    handler_duration   decimal NOT NULL,
+    status_code        bigint NOT NULL,
+    response_size      bigint DEFAULT 0,
+    is_error           boolean DEFAULT false,
+    error_message      text,
+    request_id         varchar(100),
+    timestamp          timestamptz NOT NULL,
+    user_agent         varchar(255),
+    ip_address         varchar(45)
+);
+ALTER TABLE route_metrics ADD COLUMN IF NOT EXISTS storage_mode varchar(20) NOT NULL DEFAULT 'heap';
+ALTER TABLE route_metrics ADD COLUMN IF NOT EXISTS search_mode varchar(20) NOT NULL DEFAULT 'none';
+ALTER TABLE route_metrics ADD COLUMN IF NOT EXISTS conditional boolean NOT NULL DEFAULT false;
+CREATE INDEX IF NOT EXISTS idx_route_metrics ON route_metrics (route_path);
+CREATE INDEX IF NOT EXISTS idx_id_type ON route_metrics (id_type);
+CREATE INDEX IF NOT EXISTS idx_status ON route_metrics (status_code);
+CREATE INDEX IF NOT EXISTS idx_error ON route_metrics (is_error);
+CREATE INDEX IF NOT EXISTS idx_request_id ON route_metrics (request_id);
+CREATE INDEX IF NOT EXISTS idx_timestamp ON route_metrics (timestamp);
+
+-- EXPLAIN ANALYZE plans captured by the explain plugin
+CREATE TABLE IF NOT EXISTS query_plans (
+    id                  bigserial PRIMARY KEY,
+    route_metric_id     bigint NOT NULL,
+    id_type             varchar(20) NOT NULL,
+    operation           varchar(10) NOT NULL,
+    table_name          varchar(63),
+    query               text NOT NULL,
+    plan                jsonb NOT NULL,
+    root_node           varchar(50),
+    uses_index_scan     boolean DEFAULT false,
+    uses_seq_scan       boolean DEFAULT false,
+    shared_hit_blocks   bigint DEFAULT 0,
+    shared_read_blocks  bigint DEFAULT 0,
+    planning_time       decimal DEFAULT 0,
+    execution_time      decimal DEFAULT 0,
+    timestamp           timestamptz NOT NULL
+);
+CREATE INDEX IF NOT EXISTS idx_plan_route_metric ON query_plans (route_metric_id);
+CREATE INDEX IF NOT EXISTS idx_plan_id_type ON query_plans (id_type);
+CREATE INDEX IF NOT EXISTS idx_plan_timestamp ON query_plans (timestamp);
+
+-- Results of the benchmark command
+CREATE TABLE IF NOT EXISTS write_benchmarks (
+    id                  bigserial PRIMARY KEY,
+    run_id              varchar(40) NOT NULL,
+    id_type             varchar(20) NOT NULL,
+    table_name          varchar(63) NOT NULL,
+    records             bigint NOT NULL,
+    seed                bigint,
+    wal_bytes           bigint NOT NULL,
+    wal_records         bigint NOT NULL,
+    wal_fpi             bigint NOT NULL,
+    index_pages_before  bigint NOT NULL,
+    index_pages_after   bigint NOT NULL,
+    index_blocks_read   bigint NOT NULL,
+    index_blocks_hit    bigint NOT NULL,
+    heap_blocks_read    bigint NOT NULL,
+    heap_blocks_hit     bigint NOT NULL,
+    duration            decimal NOT NULL,
+    timestamp           timestamptz NOT NULL
+);
+ALTER TABLE write_benchmarks ADD COLUMN IF NOT EXISTS seed bigint;
+CREATE INDEX IF NOT EXISTS idx_write_run ON write_benchmarks (run_id);
+CREATE INDEX IF NOT EXISTS idx_write_id_type ON write_benchmarks (id_type);
+CREATE INDEX IF NOT EXISTS idx_write_timestamp ON write_benchmarks (timestamp);
+
+-- Settings and progress of the generate command
+CREATE TABLE IF NOT EXISTS generation_runs (
+    id                 bigserial PRIMARY KEY,
+    run_id             varchar(40) NOT NULL,
+    seed               bigint NOT NULL,
+    types              varchar(100) NOT NULL,
+    records_per_table  bigint NOT NULL,
+    target_size        bigint NOT NULL,
+    batch_size         bigint NOT NULL,
+    orders_per_user    bigint NOT NULL,
+    method             varchar(20) NOT NULL,
+    status             varchar(20) NOT NULL,
+    duration           decimal NOT NULL,
+    timestamp          timestamptz NOT NULL
+);
+ALTER TABLE generation_runs ADD COLUMN IF NOT EXISTS types varchar(100) NOT NULL DEFAULT '';
+ALTER TABLE generation_runs ADD COLUMN IF NOT EXISTS target_size bigint NOT NULL DEFAULT 0;
+ALTER TABLE generation_runs ADD COLUMN IF NOT EXISTS status varchar(20) NOT NULL DEFAULT 'completed';
+CREATE UNIQUE INDEX IF NOT EXISTS idx_generation_run ON generation_runs (run_id);
+CREATE INDEX IF NOT EXISTS idx_generation_status ON generation_runs (status);
+CREATE INDEX IF NOT EXISTS idx_generation_timestamp ON generation_runs (timestamp);
+
+CREATE TABLE IF NOT EXISTS generation_checkpoints (
+    id          bigserial PRIMARY KEY,
+    run_id      varchar(40) NOT NULL,
+    id_type     varchar(20) NOT NULL,
+    completed   bigint NOT NULL,
+    updated_at  timestamptz NOT NULL
+);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_checkpoint_run_type ON generation_checkpoints (run_id, id_type);
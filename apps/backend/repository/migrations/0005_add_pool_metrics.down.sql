@@ -0,0 +1,6 @@
+DROP TABLE IF EXISTS pool_stats;
+
+ALTER TABLE route_metrics DROP COLUMN IF EXISTS pool_wait_duration;
+ALTER TABLE route_metrics DROP COLUMN IF EXISTS pool_wait_count;
+ALTER TABLE route_metrics DROP COLUMN IF EXISTS pool_in_use;
+ALTER TABLE route_metrics DROP COLUMN IF EXISTS pool_max_open;
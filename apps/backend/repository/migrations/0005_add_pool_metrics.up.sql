@@ -0,0 +1,21 @@
+-- Connection pool state of each request
+ALTER TABLE route_metrics ADD COLUMN IF NOT EXISTS pool_max_open bigint NOT NULL DEFAULT 0;
+ALTER TABLE route_metrics ADD COLUMN IF NOT EXISTS pool_in_use bigint NOT NULL DEFAULT 0;
+ALTER TABLE route_metrics ADD COLUMN IF NOT EXISTS pool_wait_count bigint NOT NULL DEFAULT 0;
+ALTER TABLE route_metrics ADD COLUMN IF NOT EXISTS pool_wait_duration decimal NOT NULL DEFAULT 0;
+
+-- Periodic connection pool samples recorded by middleware.PoolSampler
+CREATE TABLE IF NOT EXISTS pool_stats (
+    id                    bigserial PRIMARY KEY,
+    max_open              bigint NOT NULL,
+    open                  bigint NOT NULL,
+    in_use                bigint NOT NULL,
+    idle                  bigint NOT NULL,
+    wait_count            bigint NOT NULL,
+    wait_duration         decimal NOT NULL,
+    max_idle_closed       bigint NOT NULL,
+    max_idle_time_closed  bigint NOT NULL,
+    max_lifetime_closed   bigint NOT NULL,
+    timestamp             timestamptz NOT NULL
+);
+CREATE INDEX IF NOT EXISTS idx_pool_stat_timestamp ON pool_stats (timestamp);
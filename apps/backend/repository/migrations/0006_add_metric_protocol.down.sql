@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_protocol;
+
+ALTER TABLE route_metrics DROP COLUMN IF EXISTS protocol;
@@ -0,0 +1,3 @@
+-- Protocol each request arrived over, http or grpc
+ALTER TABLE route_metrics ADD COLUMN IF NOT EXISTS protocol varchar(10) NOT NULL DEFAULT 'http';
+CREATE INDEX IF NOT EXISTS idx_protocol ON route_metrics (protocol);
@@ -0,0 +1,4 @@
+-- Dropping a partitioned table drops its partitions
+DROP TABLE IF EXISTS users_snowflake_part;
+DROP TABLE IF EXISTS users_ksuid_part;
+DROP TABLE IF EXISTS users_ulid_part;
@@ -0,0 +1,46 @@
+-- Range-partitioned copies of the users tables of the time-ordered ID types.
+-- The "C" collation makes the range bounds follow byte order, the order the
+-- IDs encode their timestamps in. The partition command adds one partition
+-- per month; rows outside every month land in the default partition.
+
+CREATE TABLE IF NOT EXISTS users_ulid_part (
+    id          varchar(26) COLLATE "C",
+    user_name   varchar(25) NOT NULL,
+    first_name  varchar(40) NOT NULL,
+    last_name   varchar(40) NOT NULL,
+    email       varchar(40) NOT NULL,
+    department  varchar(25),
+    version     bigint NOT NULL DEFAULT 1,
+    PRIMARY KEY (id)
+) PARTITION BY RANGE (id);
+CREATE TABLE IF NOT EXISTS users_ulid_part_default PARTITION OF users_ulid_part DEFAULT;
+
+CREATE TABLE IF NOT EXISTS users_ksuid_part (
+    id          varchar(27) COLLATE "C",
+    user_name   varchar(25) NOT NULL,
+    first_name  varchar(40) NOT NULL,
+    last_name   varchar(40) NOT NULL,
+    email       varchar(40) NOT NULL,
+    department  varchar(25),
+    version     bigint NOT NULL DEFAULT 1,
+    PRIMARY KEY (id)
+) PARTITION BY RANGE (id);
+CREATE TABLE IF NOT EXISTS users_ksuid_part_default PARTITION OF users_ksuid_part DEFAULT;
+
+CREATE TABLE IF NOT EXISTS users_snowflake_part (
+    id          bigint,
+    user_name   varchar(25) NOT NULL,
+    first_name  varchar(40) NOT NULL,
+    last_name   varchar(40) NOT NULL,
+    email       varchar(40) NOT NULL,
+    department  varchar(25),
+    version     bigint NOT NULL DEFAULT 1,
+    PRIMARY KEY (id)
+) PARTITION BY RANGE (id);
+CREATE TABLE IF NOT EXISTS users_snowflake_part_default PARTITION OF users_snowflake_part DEFAULT;
+
+-- Tables adopted from the partition command of earlier releases may predate
+-- the version column
+ALTER TABLE users_ulid_part ADD COLUMN IF NOT EXISTS version bigint NOT NULL DEFAULT 1;
+ALTER TABLE users_ksuid_part ADD COLUMN IF NOT EXISTS version bigint NOT NULL DEFAULT 1;
+ALTER TABLE users_snowflake_part ADD COLUMN IF NOT EXISTS version bigint NOT NULL DEFAULT 1;
@@ -0,0 +1,45 @@
+DROP INDEX IF EXISTS idx_users_ulid_user_name_trgm;
+DROP INDEX IF EXISTS idx_users_ulid_first_name_trgm;
+DROP INDEX IF EXISTS idx_users_ulid_last_name_trgm;
+DROP INDEX IF EXISTS idx_users_ulid_email_trgm;
+DROP INDEX IF EXISTS idx_users_ulid_search_tsv;
+DROP INDEX IF EXISTS idx_users_ksuid_user_name_trgm;
+DROP INDEX IF EXISTS idx_users_ksuid_first_name_trgm;
+DROP INDEX IF EXISTS idx_users_ksuid_last_name_trgm;
+DROP INDEX IF EXISTS idx_users_ksuid_email_trgm;
+DROP INDEX IF EXISTS idx_users_ksuid_search_tsv;
+DROP INDEX IF EXISTS idx_users_uuid_user_name_trgm;
+DROP INDEX IF EXISTS idx_users_uuid_first_name_trgm;
+DROP INDEX IF EXISTS idx_users_uuid_last_name_trgm;
+DROP INDEX IF EXISTS idx_users_uuid_email_trgm;
+DROP INDEX IF EXISTS idx_users_uuid_search_tsv;
+DROP INDEX IF EXISTS idx_users_cuid_user_name_trgm;
+DROP INDEX IF EXISTS idx_users_cuid_first_name_trgm;
+DROP INDEX IF EXISTS idx_users_cuid_last_name_trgm;
+DROP INDEX IF EXISTS idx_users_cuid_email_trgm;
+DROP INDEX IF EXISTS idx_users_cuid_search_tsv;
+DROP INDEX IF EXISTS idx_users_nanoid_user_name_trgm;
+DROP INDEX IF EXISTS idx_users_nanoid_first_name_trgm;
+DROP INDEX IF EXISTS idx_users_nanoid_last_name_trgm;
+DROP INDEX IF EXISTS idx_users_nanoid_email_trgm;
+DROP INDEX IF EXISTS idx_users_nanoid_search_tsv;
+DROP INDEX IF EXISTS idx_users_snowflake_user_name_trgm;
+DROP INDEX IF EXISTS idx_users_snowflake_first_name_trgm;
+DROP INDEX IF EXISTS idx_users_snowflake_last_name_trgm;
+DROP INDEX IF EXISTS idx_users_snowflake_email_trgm;
+DROP INDEX IF EXISTS idx_users_snowflake_search_tsv;
+DROP INDEX IF EXISTS idx_users_ulid_part_user_name_trgm;
+DROP INDEX IF EXISTS idx_users_ulid_part_first_name_trgm;
+DROP INDEX IF EXISTS idx_users_ulid_part_last_name_trgm;
+DROP INDEX IF EXISTS idx_users_ulid_part_email_trgm;
+DROP INDEX IF EXISTS idx_users_ulid_part_search_tsv;
+DROP INDEX IF EXISTS idx_users_ksuid_part_user_name_trgm;
+DROP INDEX IF EXISTS idx_users_ksuid_part_first_name_trgm;
+DROP INDEX IF EXISTS idx_users_ksuid_part_last_name_trgm;
+DROP INDEX IF EXISTS idx_users_ksuid_part_email_trgm;
+DROP INDEX IF EXISTS idx_users_ksuid_part_search_tsv;
+DROP INDEX IF EXISTS idx_users_snowflake_part_user_name_trgm;
+DROP INDEX IF EXISTS idx_users_snowflake_part_first_name_trgm;
+DROP INDEX IF EXISTS idx_users_snowflake_part_last_name_trgm;
+DROP INDEX IF EXISTS idx_users_snowflake_part_email_trgm;
+DROP INDEX IF EXISTS idx_users_snowflake_part_search_tsv;
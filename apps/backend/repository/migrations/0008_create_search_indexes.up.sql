@@ -0,0 +1,59 @@
+-- Search indexes of every users table. The trigram indexes serve the ILIKE
+-- conditions of search.mode trigram, and the tsvector index the full-text
+-- query of search.mode fulltext, whose expression it must match exactly. The
+-- ilike mode matches lower(column), which neither serves. Indexes on the
+-- partitioned tables cascade to their partitions.
+
+CREATE INDEX IF NOT EXISTS idx_users_ulid_user_name_trgm ON users_ulid USING gin (user_name gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS idx_users_ulid_first_name_trgm ON users_ulid USING gin (first_name gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS idx_users_ulid_last_name_trgm ON users_ulid USING gin (last_name gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS idx_users_ulid_email_trgm ON users_ulid USING gin (email gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS idx_users_ulid_search_tsv ON users_ulid USING gin (to_tsvector('simple', coalesce(user_name, '') || ' ' || coalesce(first_name, '') || ' ' || coalesce(last_name, '') || ' ' || coalesce(email, '')));
+
+CREATE INDEX IF NOT EXISTS idx_users_ksuid_user_name_trgm ON users_ksuid USING gin (user_name gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS idx_users_ksuid_first_name_trgm ON users_ksuid USING gin (first_name gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS idx_users_ksuid_last_name_trgm ON users_ksuid USING gin (last_name gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS idx_users_ksuid_email_trgm ON users_ksuid USING gin (email gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS idx_users_ksuid_search_tsv ON users_ksuid USING gin (to_tsvector('simple', coalesce(user_name, '') || ' ' || coalesce(first_name, '') || ' ' || coalesce(last_name, '') || ' ' || coalesce(email, '')));
+
+CREATE INDEX IF NOT EXISTS idx_users_uuid_user_name_trgm ON users_uuid USING gin (user_name gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS idx_users_uuid_first_name_trgm ON users_uuid USING gin (first_name gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS idx_users_uuid_last_name_trgm ON users_uuid USING gin (last_name gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS idx_users_uuid_email_trgm ON users_uuid USING gin (email gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS idx_users_uuid_search_tsv ON users_uuid USING gin (to_tsvector('simple', coalesce(user_name, '') || ' ' || coalesce(first_name, '') || ' ' || coalesce(last_name, '') || ' ' || coalesce(email, '')));
+
+CREATE INDEX IF NOT EXISTS idx_users_cuid_user_name_trgm ON users_cuid USING gin (user_name gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS idx_users_cuid_first_name_trgm ON users_cuid USING gin (first_name gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS idx_users_cuid_last_name_trgm ON users_cuid USING gin (last_name gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS idx_users_cuid_email_trgm ON users_cuid USING gin (email gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS idx_users_cuid_search_tsv ON users_cuid USING gin (to_tsvector('simple', coalesce(user_name, '') || ' ' || coalesce(first_name, '') || ' ' || coalesce(last_name, '') || ' ' || coalesce(email, '')));
+
+CREATE INDEX IF NOT EXISTS idx_users_nanoid_user_name_trgm ON users_nanoid USING gin (user_name gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS idx_users_nanoid_first_name_trgm ON users_nanoid USING gin (first_name gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS idx_users_nanoid_last_name_trgm ON users_nanoid USING gin (last_name gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS idx_users_nanoid_email_trgm ON users_nanoid USING gin (email gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS idx_users_nanoid_search_tsv ON users_nanoid USING gin (to_tsvector('simple', coalesce(user_name, '') || ' ' || coalesce(first_name, '') || ' ' || coalesce(last_name, '') || ' ' || coalesce(email, '')));
+
+CREATE INDEX IF NOT EXISTS idx_users_snowflake_user_name_trgm ON users_snowflake USING gin (user_name gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS idx_users_snowflake_first_name_trgm ON users_snowflake USING gin (first_name gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS idx_users_snowflake_last_name_trgm ON users_snowflake USING gin (last_name gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS idx_users_snowflake_email_trgm ON users_snowflake USING gin (email gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS idx_users_snowflake_search_tsv ON users_snowflake USING gin (to_tsvector('simple', coalesce(user_name, '') || ' ' || coalesce(first_name, '') || ' ' || coalesce(last_name, '') || ' ' || coalesce(email, '')));
+
+CREATE INDEX IF NOT EXISTS idx_users_ulid_part_user_name_trgm ON users_ulid_part USING gin (user_name gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS idx_users_ulid_part_first_name_trgm ON users_ulid_part USING gin (first_name gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS idx_users_ulid_part_last_name_trgm ON users_ulid_part USING gin (last_name gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS idx_users_ulid_part_email_trgm ON users_ulid_part USING gin (email gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS idx_users_ulid_part_search_tsv ON users_ulid_part USING gin (to_tsvector('simple', coalesce(user_name, '') || ' ' || coalesce(first_name, '') || ' ' || coalesce(last_name, '') || ' ' || coalesce(email, '')));
+
+CREATE INDEX IF NOT EXISTS idx_users_ksuid_part_user_name_trgm ON users_ksuid_part USING gin (user_name gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS idx_users_ksuid_part_first_name_trgm ON users_ksuid_part USING gin (first_name gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS idx_users_ksuid_part_last_name_trgm ON users_ksuid_part USING gin (last_name gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS idx_users_ksuid_part_email_trgm ON users_ksuid_part USING gin (email gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS idx_users_ksuid_part_search_tsv ON users_ksuid_part USING gin (to_tsvector('simple', coalesce(user_name, '') || ' ' || coalesce(first_name, '') || ' ' || coalesce(last_name, '') || ' ' || coalesce(email, '')));
+
+CREATE INDEX IF NOT EXISTS idx_users_snowflake_part_user_name_trgm ON users_snowflake_part USING gin (user_name gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS idx_users_snowflake_part_first_name_trgm ON users_snowflake_part USING gin (first_name gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS idx_users_snowflake_part_last_name_trgm ON users_snowflake_part USING gin (last_name gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS idx_users_snowflake_part_email_trgm ON users_snowflake_part USING gin (email gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS idx_users_snowflake_part_search_tsv ON users_snowflake_part USING gin (to_tsvector('simple', coalesce(user_name, '') || ' ' || coalesce(first_name, '') || ' ' || coalesce(last_name, '') || ' ' || coalesce(email, '')));
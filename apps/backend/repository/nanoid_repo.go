@@ -1,7 +1,7 @@
 package repository
 
 import (
-	"errors"
+	"context"
 	"math"
 
 	gonanoid "github.com/matoous/go-nanoid/v2"
@@ -21,12 +21,19 @@ func NewGormNanoIdRepository(repo *gorm.DB) IRepository[model.UserNanoID] {
 	}
 }
 
+// WithContext returns a copy of the repository whose queries run with ctx.
+func (uc *GormNanoIdRepository) WithContext(ctx context.Context) IRepository[model.UserNanoID] {
+	return &GormNanoIdRepository{
+		DB: uc.DB.WithContext(ctx),
+	}
+}
+
 // GetUser retrieves a user by its HASH column.
 func (uc *GormNanoIdRepository) GetUser(hashId string) (*model.UserNanoID, error) {
 	var user model.UserNanoID
 	// Ensure the table name is correctly referenced (if needed, use )
 	if err := uc.DB.Where("id = ?", hashId).First(&user).Error; err != nil {
-		return nil, err
+		return nil, translateError(err, "user")
 	}
 	return &user, nil
 }
@@ -40,17 +47,11 @@ func (uc *GormNanoIdRepository) GetUsers(search string, page, limit int) (*model
 	// Use db.Model instead of db.Table
 	query := uc.DB.Model(&model.UserNanoID{})
 
-	if search != "" {
-		likeSearch := "%" + search + "%"
-		query = query.Where(
-			"user_name ILIKE ? OR first_name ILIKE ? OR last_name ILIKE ? OR email ILIKE ?",
-			likeSearch, likeSearch, likeSearch, likeSearch,
-		)
-	}
+	query = ApplySearch(query, search)
 
 	// Count total matching records
 	if err := query.Count(&totalCount).Error; err != nil {
-		return nil, err
+		return nil, translateError(err, "user")
 	}
 
 	offset := (page - 1) * limit
@@ -60,7 +61,7 @@ func (uc *GormNanoIdRepository) GetUsers(search string, page, limit int) (*model
 
 	// Remove explicit Select, let GORM handle field mapping
 	if err := query.Offset(offset).Limit(limit).Find(&users).Error; err != nil {
-		return nil, err
+		return nil, translateError(err, "user")
 	}
 
 	// Calculate the actual page count
@@ -89,58 +90,64 @@ func (uc *GormNanoIdRepository) CreateUser(requestedUser model.UserNanoID) (*mod
 	// Generate a new UUID for the user.
 	id, err := gonanoid.New()
 	if err != nil {
-		return nil, err
+		return nil, translateError(err, "user")
 	}
 	requestedUser.ID = id
 
 	// Insert the record into the USERS table.
 	if err := uc.DB.Create(&requestedUser).Error; err != nil {
-		return nil, err
+		return nil, translateError(err, "user")
 	}
 	return &requestedUser, nil
 }
 
-// UpdateUser updates an existing user's details.
+// UpdateUser replaces an existing user's details. Empty fields are written
+// as-is, so a missing department clears it. A non-zero Version must match the
+// stored one.
 func (uc *GormNanoIdRepository) UpdateUser(requestedUser model.UserNanoID) (*model.UserNanoID, error) {
 	var user model.UserNanoID
-	// Retrieve the user to be updated by its HASH.
-	if err := uc.DB.Where("id LIKE ?", requestedUser.ID).First(&user).Error; err != nil {
+	if err := replaceUser(uc.DB, &user, requestedUser.ID, requestedUser.UserBase); err != nil {
 		return nil, err
 	}
-	if user.ID == "" {
-		return nil, errors.New("user not found")
-	}
+	return &user, nil
+}
 
-	// Update fields if provided.
-	if requestedUser.Department != nil && *requestedUser.Department != "" {
-		user.Department = requestedUser.Department
-	}
-	if requestedUser.FirstName != "" {
-		user.FirstName = requestedUser.FirstName
-	}
-	if requestedUser.LastName != "" {
-		user.LastName = requestedUser.LastName
+// DeleteUser removes a user record based on its HASH.
+func (uc *GormNanoIdRepository) DeleteUser(id string) error {
+	result := uc.DB.Where("id = ?", id).Delete(&model.UserNanoID{})
+	if result.Error != nil {
+		return translateError(result.Error, "user")
 	}
-	if requestedUser.Email != "" {
-		user.Email = requestedUser.Email
+	if result.RowsAffected == 0 {
+		return NotFound("user", id)
 	}
+	return nil
+}
 
-	// Update the record in the USERS table.
-	if err := uc.DB.Where("id = ?", user.ID).Updates(user).Error; err != nil {
-		return nil, err
+// CreateUsers inserts users with multi-row INSERTs after giving each a new ID.
+func (uc *GormNanoIdRepository) CreateUsers(requestedUsers []model.UserNanoID, atomic bool) ([]BatchResult[model.UserNanoID], error) {
+	for i := range requestedUsers {
+		id, err := gonanoid.New()
+		if err != nil {
+			return nil, translateError(err, "user")
+		}
+		requestedUsers[i].ID = id
 	}
+	return createUsers(uc.DB, requestedUsers, atomic)
+}
 
-	// Optionally, re-fetch the updated record.
-	if err := uc.DB.Where("id = ?", user.ID).First(&user).Error; err != nil {
-		return nil, err
-	}
-	return &user, nil
+// GetUsersByID retrieves the users among ids in one query.
+func (uc *GormNanoIdRepository) GetUsersByID(ids []string) ([]model.UserNanoID, error) {
+	return getUsersByID[model.UserNanoID](uc.DB, ids)
 }
 
-// DeleteUser removes a user record based on its HASH.
-func (uc *GormNanoIdRepository) DeleteUser(id string) error {
-	if err := uc.DB.Where("id = ?", id).Delete(&model.UserNanoID{}).Error; err != nil {
-		return err
-	}
-	return nil
+// DeleteUsers removes the users among ids and returns the IDs it deleted.
+func (uc *GormNanoIdRepository) DeleteUsers(ids []string, atomic bool) ([]string, error) {
+	return deleteUsers(uc.DB, &model.UserNanoID{}, ids, atomic)
+}
+
+// ExportUsers streams the users matching search to emit with a keyset scan.
+func (uc *GormNanoIdRepository) ExportUsers(search string, emit func(*model.UserDTO) error) error {
+	key := func(user *model.UserNanoID) interface{} { return user.ID }
+	return exportUsers(uc.DB, search, key, (*model.UserNanoID).NanoIdToDTO, emit)
 }
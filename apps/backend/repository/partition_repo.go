@@ -0,0 +1,183 @@
+package repository
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/bwmarrin/snowflake"
+	"github.com/oklog/ulid/v2"
+	"github.com/segmentio/ksuid"
+	"gorm.io/gorm"
+
+	model "github.com/theCompanyDream/id-trials/apps/backend/models"
+	"github.com/theCompanyDream/id-trials/apps/backend/models/stats"
+	"github.com/theCompanyDream/id-trials/apps/backend/utils"
+)
+
+// PartitionSpec describes how a users table with a time-ordered ID is range
+// partitioned. Bound returns the smallest ID that could be generated at t.
+type PartitionSpec struct {
+	IDType string
+	Source string
+	Table  string
+	Model  interface{}
+	Bound  func(t time.Time) (interface{}, error)
+}
+
+// PartitionSpecs are the time-ordered ID types with a users table. UUIDv7 is
+// time-ordered too, but the UUID type generates v4 IDs, so it has none.
+var PartitionSpecs = []PartitionSpec{
+	{"ULID", model.UserUlid{}.TableName(), model.UserUlidPartitioned{}.TableName(), &model.UserUlidPartitioned{}, ulidBound},
+	{"KSUID", model.UserKSUID{}.TableName(), model.UserKSUIDPartitioned{}.TableName(), &model.UserKSUIDPartitioned{}, ksuidBound},
+	{"Snowflake", model.UserSnowflake{}.TableName(), model.UserSnowflakePartitioned{}.TableName(), &model.UserSnowflakePartitioned{}, snowflakeBound},
+}
+
+func ulidBound(t time.Time) (interface{}, error) {
+	var id ulid.ULID
+	if err := id.SetTime(ulid.Timestamp(t)); err != nil {
+		return nil, err
+	}
+	return id.String(), nil
+}
+
+func ksuidBound(t time.Time) (interface{}, error) {
+	id, err := ksuid.FromParts(t, make([]byte, 16))
+	if err != nil {
+		return nil, err
+	}
+	return id.String(), nil
+}
+
+func snowflakeBound(t time.Time) (interface{}, error) {
+	ms := t.UnixMilli() - snowflake.Epoch
+	if ms < 0 {
+		ms = 0
+	}
+	return ms << (snowflake.NodeBits + snowflake.StepBits), nil
+}
+
+// PartitionedStorageEnabled reports whether storage.partitioned is set, in
+// which case the time-ordered ID types read and write their partitioned tables.
+func PartitionedStorageEnabled() bool {
+	return partitionedStorage
+}
+
+func partitionSpecFor(source string) *PartitionSpec {
+	for i := range PartitionSpecs {
+		if PartitionSpecs[i].Source == source || PartitionSpecs[i].IDType == source {
+			return &PartitionSpecs[i]
+		}
+	}
+	return nil
+}
+
+// StorageFor scopes db to the partitioned copy of source when partitioned
+// storage is enabled and source has one, and returns db unchanged otherwise.
+func StorageFor(db *gorm.DB, source string) *gorm.DB {
+	spec := partitionSpecFor(source)
+	if spec == nil || !PartitionedStorageEnabled() {
+		return db
+	}
+	return db.Table(spec.Table).Session(&gorm.Session{})
+}
+
+// StorageMode returns the storage mode requests for an ID type are served from.
+func StorageMode(idType string) string {
+	if partitionSpecFor(idType) != nil && PartitionedStorageEnabled() {
+		return "partitioned"
+	}
+	return "heap"
+}
+
+type PartitionRepository struct {
+	DB *gorm.DB
+}
+
+func NewPartitionRepository(db *gorm.DB) *PartitionRepository {
+	return &PartitionRepository{DB: db}
+}
+
+// CreateMonthPartition creates the partition holding IDs generated during the
+// calendar month (UTC) that contains month.
+func (r *PartitionRepository) CreateMonthPartition(spec PartitionSpec, month time.Time) (string, error) {
+	start := time.Date(month.Year(), month.Month(), 1, 0, 0, 0, 0, time.UTC)
+	end := start.AddDate(0, 1, 0)
+
+	lower, err := spec.Bound(start)
+	if err != nil {
+		return "", err
+	}
+	upper, err := spec.Bound(end)
+	if err != nil {
+		return "", err
+	}
+
+	// DDL does not accept bind parameters, so the bounds are inlined
+	name := fmt.Sprintf("%s_p%s", spec.Table, start.Format("2006_01"))
+	err = r.DB.Exec(fmt.Sprintf(
+		"CREATE TABLE IF NOT EXISTS %s PARTITION OF %s FOR VALUES FROM (%s) TO (%s)",
+		name, spec.Table, boundLiteral(lower), boundLiteral(upper),
+	)).Error
+
+	return name, err
+}
+
+// boundLiteral renders a bound as SQL. String bounds are base32 or base62
+// encoded IDs, so they never contain quotes.
+func boundLiteral(bound interface{}) string {
+	if text, ok := bound.(string); ok {
+		return "'" + text + "'"
+	}
+	return fmt.Sprint(bound)
+}
+
+// Backfill copies rows from the unpartitioned table that are not yet present.
+func (r *PartitionRepository) Backfill(spec PartitionSpec) (int64, error) {
+	stmt := &gorm.Statement{DB: r.DB}
+	if err := stmt.Parse(spec.Model); err != nil {
+		return 0, err
+	}
+	columns := strings.Join(stmt.Schema.DBNames, ", ")
+
+	result := r.DB.Exec(fmt.Sprintf(
+		"INSERT INTO %s (%s) SELECT %s FROM %s ON CONFLICT (id) DO NOTHING",
+		spec.Table, columns, columns, spec.Source,
+	))
+	return result.RowsAffected, result.Error
+}
+
+func (r *PartitionRepository) ListPartitions(spec PartitionSpec) ([]stats.PartitionInfo, error) {
+	var partitions []stats.PartitionInfo
+
+	err := r.DB.Raw(`
+		SELECT
+			c.relname AS name,
+			COALESCE(SUBSTRING(pg_get_expr(c.relpartbound, c.oid) FROM 'FROM \((.*)\) TO'), '') AS lower_bound,
+			COALESCE(SUBSTRING(pg_get_expr(c.relpartbound, c.oid) FROM 'TO \((.*)\)'), '') AS upper_bound
+		FROM pg_inherits i
+		JOIN pg_class c ON c.oid = i.inhrelid
+		WHERE i.inhparent = ?::regclass
+		ORDER BY c.relname
+	`, spec.Table).Scan(&partitions).Error
+
+	return partitions, err
+}
+
+// ExplainRange explains a scan of every row whose ID was generated since t.
+func (r *PartitionRepository) ExplainRange(table string, spec PartitionSpec, since time.Time) (stats.PlanSummary, error) {
+	bound, err := spec.Bound(since)
+	if err != nil {
+		return stats.PlanSummary{}, err
+	}
+
+	var plan string
+	err = r.DB.Raw(fmt.Sprintf(
+		"EXPLAIN (ANALYZE, BUFFERS, FORMAT JSON) SELECT COUNT(*) FROM %s WHERE id >= ?", table,
+	), bound).Row().Scan(&plan)
+	if err != nil {
+		return stats.PlanSummary{}, err
+	}
+
+	return utils.SummarizePlan(plan)
+}
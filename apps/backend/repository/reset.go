@@ -0,0 +1,35 @@
+package repository
+
+import (
+	"fmt"
+	"strings"
+
+	"gorm.io/gorm"
+)
+
+// TableNames resolves models to their table names.
+func TableNames(db *gorm.DB, models ...interface{}) ([]string, error) {
+	names := make([]string, len(models))
+	for i, model := range models {
+		stmt := &gorm.Statement{DB: db}
+		if err := stmt.Parse(model); err != nil {
+			return nil, err
+		}
+		names[i] = stmt.Schema.Table
+	}
+	return names, nil
+}
+
+// TruncateTables empties the tables of models in one TRUNCATE, restarting
+// their sequences. CASCADE also empties tables that reference them.
+func TruncateTables(db *gorm.DB, models ...interface{}) error {
+	names, err := TableNames(db, models...)
+	if err != nil || len(names) == 0 {
+		return err
+	}
+	quoted := make([]string, len(names))
+	for i, name := range names {
+		quoted[i] = db.Statement.Quote(name)
+	}
+	return db.Exec(fmt.Sprintf("TRUNCATE TABLE %s RESTART IDENTITY CASCADE", strings.Join(quoted, ", "))).Error
+}
@@ -0,0 +1,68 @@
+package repository
+
+import (
+	"strings"
+	"unicode"
+
+	"gorm.io/gorm"
+
+	"github.com/theCompanyDream/id-trials/apps/backend/config"
+)
+
+// Search modes selected with search.mode. ilike is the unindexed baseline.
+const (
+	SearchModeILike    = config.SearchModeILike
+	SearchModeTrigram  = config.SearchModeTrigram
+	SearchModeFullText = config.SearchModeFullText
+)
+
+// searchDocument must match the expression of the idx_*_search_tsv indexes in
+// the migrations exactly, otherwise the planner cannot use them.
+const searchDocument = "to_tsvector('simple', coalesce(user_name, '') || ' ' || coalesce(first_name, '') || ' ' || coalesce(last_name, '') || ' ' || coalesce(email, ''))"
+
+// SearchMode returns the configured search mode, defaulting to ilike.
+func SearchMode() string {
+	return searchMode
+}
+
+// ApplySearch adds the search condition of the configured mode to query.
+func ApplySearch(query *gorm.DB, search string) *gorm.DB {
+	if search == "" {
+		return query
+	}
+
+	if SearchMode() == SearchModeFullText {
+		if tsQuery := prefixTSQuery(search); tsQuery != "" {
+			return query.Where(searchDocument+" @@ to_tsquery('simple', ?)", tsQuery)
+		}
+	}
+
+	likeSearch := "%" + search + "%"
+	if SearchMode() == SearchModeILike {
+		// Every search index exists in every mode, so the unindexed baseline
+		// matches lower(column), which no index covers
+		likeSearch = strings.ToLower(likeSearch)
+		return query.Where(
+			"lower(user_name) LIKE ? OR lower(first_name) LIKE ? OR lower(last_name) LIKE ? OR lower(email) LIKE ?",
+			likeSearch, likeSearch, likeSearch, likeSearch,
+		)
+	}
+
+	// The pg_trgm indexes serve ILIKE
+	return query.Where(
+		"user_name ILIKE ? OR first_name ILIKE ? OR last_name ILIKE ? OR email ILIKE ?",
+		likeSearch, likeSearch, likeSearch, likeSearch,
+	)
+}
+
+// prefixTSQuery turns free text into a tsquery that prefix-matches every word,
+// dropping characters that carry meaning in tsquery syntax.
+func prefixTSQuery(search string) string {
+	var terms []string
+	for _, word := range strings.FieldsFunc(search, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	}) {
+		terms = append(terms, word+":*")
+	}
+	return strings.Join(terms, " & ")
+}
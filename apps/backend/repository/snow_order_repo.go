@@ -0,0 +1,162 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/bwmarrin/snowflake"
+	"gorm.io/gorm"
+
+	model "github.com/theCompanyDream/id-trials/apps/backend/models"
+)
+
+type GormSnowOrderRepository struct {
+	DB   *gorm.DB
+	Node *snowflake.Node
+}
+
+// NewGormSnowOrderRepository creates a new instance of GormSnowOrderRepository.
+func NewGormSnowOrderRepository(repo *gorm.DB) IOrderRepository[model.OrderSnowflake] {
+	node, _ := snowflake.NewNode(1)
+	return &GormSnowOrderRepository{
+		DB:   repo,
+		Node: node,
+	}
+}
+
+// WithContext returns a copy of the repository whose queries run with ctx.
+func (uc *GormSnowOrderRepository) WithContext(ctx context.Context) IOrderRepository[model.OrderSnowflake] {
+	return &GormSnowOrderRepository{
+		DB:   uc.DB.WithContext(ctx),
+		Node: uc.Node,
+	}
+}
+
+// GetOrder retrieves an order by its ID.
+func (uc *GormSnowOrderRepository) GetOrder(id string) (*model.OrderSnowflake, error) {
+	var order model.OrderSnowflake
+	if err := uc.DB.Where("id = ?", id).First(&order).Error; err != nil {
+		return nil, translateError(err, "order")
+	}
+	return &order, nil
+}
+
+// GetOrdersByUser retrieves a page of a user's orders by joining them to their parent.
+func (uc *GormSnowOrderRepository) GetOrdersByUser(userId string, page, limit int) (*model.OrderPaging, error) {
+	var orders []model.OrderDTO
+	var totalCount int64
+
+	query := uc.DB.Table(model.OrderSnowflake{}.TableName()+" AS o").
+		Joins("JOIN "+model.UserSnowflake{}.TableName()+" AS u ON u.id = o.user_id").
+		Where("u.id = ?", userId)
+
+	// Count total matching records
+	if err := query.Count(&totalCount).Error; err != nil {
+		return nil, translateError(err, "order")
+	}
+	if totalCount == 0 {
+		if err := requireUser(uc.DB, model.UserSnowflake{}.TableName(), userId); err != nil {
+			return nil, err
+		}
+	}
+
+	offset := (page - 1) * limit
+	if offset < 0 {
+		offset = 0
+	}
+
+	if err := query.
+		Select("o.id, o.user_id, u.user_name, o.product, o.quantity, o.total_cents, o.status, o.created_at").
+		Order("o.created_at ASC").
+		Offset(offset).Limit(limit).
+		Scan(&orders).Error; err != nil {
+		return nil, translateError(err, "order")
+	}
+
+	// Calculate the actual page count
+	pageCount := int(math.Ceil(float64(totalCount) / float64(limit)))
+
+	if orders == nil {
+		orders = make([]model.OrderDTO, 0)
+	}
+
+	return &model.OrderPaging{
+		Paging: model.Paging{
+			Page:      &page,
+			PageCount: &pageCount,
+			PageSize:  &limit,
+		},
+		Orders: orders,
+	}, nil
+}
+
+// CreateOrder creates a new order record for an existing user.
+func (uc *GormSnowOrderRepository) CreateOrder(requestedOrder model.OrderSnowflake) (*model.OrderSnowflake, error) {
+	id := uc.Node.Generate()
+	requestedOrder.ID = id.Int64()
+	if requestedOrder.OrderBase == nil {
+		requestedOrder.OrderBase = &model.OrderBase{}
+	}
+	if requestedOrder.Quantity == 0 {
+		requestedOrder.Quantity = 1
+	}
+	if requestedOrder.Status == "" {
+		requestedOrder.Status = "pending"
+	}
+	requestedOrder.CreatedAt = time.Now().UTC()
+
+	if err := uc.DB.Omit("User").Create(&requestedOrder).Error; err != nil {
+		return nil, translateError(err, "order")
+	}
+	return &requestedOrder, nil
+}
+
+// UpdateOrder updates an existing order's details.
+func (uc *GormSnowOrderRepository) UpdateOrder(requestedOrder model.OrderSnowflake) (*model.OrderSnowflake, error) {
+	var order model.OrderSnowflake
+	if err := uc.DB.Where("id = ?", requestedOrder.ID).First(&order).Error; err != nil {
+		return nil, translateError(err, "order")
+	}
+	if order.ID == 0 {
+		return nil, NotFound("order", fmt.Sprint(requestedOrder.ID))
+	}
+
+	// Update fields if provided.
+	if requestedOrder.OrderBase != nil {
+		if requestedOrder.Product != "" {
+			order.Product = requestedOrder.Product
+		}
+		if requestedOrder.Quantity != 0 {
+			order.Quantity = requestedOrder.Quantity
+		}
+		if requestedOrder.TotalCents != 0 {
+			order.TotalCents = requestedOrder.TotalCents
+		}
+		if requestedOrder.Status != "" {
+			order.Status = requestedOrder.Status
+		}
+	}
+
+	if err := uc.DB.Omit("User").Where("id = ?", order.ID).Updates(order).Error; err != nil {
+		return nil, translateError(err, "order")
+	}
+
+	if err := uc.DB.Where("id = ?", order.ID).First(&order).Error; err != nil {
+		return nil, translateError(err, "order")
+	}
+	return &order, nil
+}
+
+// DeleteOrder removes an order record based on its ID.
+func (uc *GormSnowOrderRepository) DeleteOrder(id string) error {
+	result := uc.DB.Where("id = ?", id).Delete(&model.OrderSnowflake{})
+	if result.Error != nil {
+		return translateError(result.Error, "order")
+	}
+	if result.RowsAffected == 0 {
+		return NotFound("order", id)
+	}
+	return nil
+}
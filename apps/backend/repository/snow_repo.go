@@ -1,7 +1,7 @@
 package repository
 
 import (
-	"errors"
+	"context"
 	"math"
 
 	"github.com/bwmarrin/snowflake"
@@ -24,12 +24,20 @@ func NewGormSnowRepository(repo *gorm.DB) IRepository[model.UserSnowflake] {
 	}
 }
 
+// WithContext returns a copy of the repository whose queries run with ctx.
+func (uc *GormSnowRepository) WithContext(ctx context.Context) IRepository[model.UserSnowflake] {
+	return &GormSnowRepository{
+		DB:   uc.DB.WithContext(ctx),
+		Node: uc.Node,
+	}
+}
+
 // GetUser retrieves a user by its HASH column.
 func (uc *GormSnowRepository) GetUser(hashId string) (*model.UserSnowflake, error) {
 	var user model.UserSnowflake
 	// Ensure the table name is correctly referenced (if needed, use )
 	if err := uc.DB.Where("id = ?", hashId).First(&user).Error; err != nil {
-		return nil, err
+		return nil, translateError(err, "user")
 	}
 	return &user, nil
 }
@@ -43,17 +51,11 @@ func (uc *GormSnowRepository) GetUsers(search string, page, limit int) (*model.U
 	// Use db.Model instead of db.Table
 	query := uc.DB.Model(&model.UserSnowflake{})
 
-	if search != "" {
-		likeSearch := "%" + search + "%"
-		query = query.Where(
-			"user_name ILIKE ? OR first_name ILIKE ? OR last_name ILIKE ? OR email ILIKE ?",
-			likeSearch, likeSearch, likeSearch, likeSearch,
-		)
-	}
+	query = ApplySearch(query, search)
 
 	// Count total matching records
 	if err := query.Count(&totalCount).Error; err != nil {
-		return nil, err
+		return nil, translateError(err, "user")
 	}
 
 	offset := (page - 1) * limit
@@ -63,7 +65,7 @@ func (uc *GormSnowRepository) GetUsers(search string, page, limit int) (*model.U
 
 	// Remove explicit Select, let GORM handle field mapping
 	if err := query.Offset(offset).Limit(limit).Find(&users).Error; err != nil {
-		return nil, err
+		return nil, translateError(err, "user")
 	}
 
 	// Calculate the actual page count
@@ -95,52 +97,54 @@ func (uc *GormSnowRepository) CreateUser(requestedUser model.UserSnowflake) (*mo
 
 	// Insert the record into the USERS table.
 	if err := uc.DB.Create(&requestedUser).Error; err != nil {
-		return nil, err
+		return nil, translateError(err, "user")
 	}
 	return &requestedUser, nil
 }
 
-// UpdateUser updates an existing user's details.
+// UpdateUser replaces an existing user's details. Empty fields are written
+// as-is, so a missing department clears it. A non-zero Version must match the
+// stored one.
 func (uc *GormSnowRepository) UpdateUser(requestedUser model.UserSnowflake) (*model.UserSnowflake, error) {
 	var user model.UserSnowflake
-	// Retrieve the user to be updated by its HASH.
-	if err := uc.DB.Where("id LIKE ?", requestedUser.ID).First(&user).Error; err != nil {
+	if err := replaceUser(uc.DB, &user, requestedUser.ID, requestedUser.UserBase); err != nil {
 		return nil, err
 	}
-	if user.ID == 0 {
-		return nil, errors.New("user not found")
-	}
+	return &user, nil
+}
 
-	// Update fields if provided.
-	if requestedUser.Department != nil && *requestedUser.Department != "" {
-		user.Department = requestedUser.Department
-	}
-	if requestedUser.FirstName != "" {
-		user.FirstName = requestedUser.FirstName
-	}
-	if requestedUser.LastName != "" {
-		user.LastName = requestedUser.LastName
+// DeleteUser removes a user record based on its HASH.
+func (uc *GormSnowRepository) DeleteUser(id string) error {
+	result := uc.DB.Where("id = ?", id).Delete(&model.UserSnowflake{})
+	if result.Error != nil {
+		return translateError(result.Error, "user")
 	}
-	if requestedUser.Email != "" {
-		user.Email = requestedUser.Email
+	if result.RowsAffected == 0 {
+		return NotFound("user", id)
 	}
+	return nil
+}
 
-	// Update the record in the USERS table.
-	if err := uc.DB.Where("id = ?", user.ID).Updates(user).Error; err != nil {
-		return nil, err
+// CreateUsers inserts users with multi-row INSERTs after giving each a new ID.
+func (uc *GormSnowRepository) CreateUsers(requestedUsers []model.UserSnowflake, atomic bool) ([]BatchResult[model.UserSnowflake], error) {
+	for i := range requestedUsers {
+		requestedUsers[i].ID = uc.Node.Generate().Int64()
 	}
+	return createUsers(uc.DB, requestedUsers, atomic)
+}
 
-	// Optionally, re-fetch the updated record.
-	if err := uc.DB.Where("id = ?", user.ID).First(&user).Error; err != nil {
-		return nil, err
-	}
-	return &user, nil
+// GetUsersByID retrieves the users among ids in one query.
+func (uc *GormSnowRepository) GetUsersByID(ids []string) ([]model.UserSnowflake, error) {
+	return getUsersByID[model.UserSnowflake](uc.DB, ids)
 }
 
-// DeleteUser removes a user record based on its HASH.
-func (uc *GormSnowRepository) DeleteUser(id string) error {
-	if err := uc.DB.Where("id = ?", id).Delete(&model.UserSnowflake{}).Error; err != nil {
-		return err
-	}
-	return nil
+// DeleteUsers removes the users among ids and returns the IDs it deleted.
+func (uc *GormSnowRepository) DeleteUsers(ids []string, atomic bool) ([]string, error) {
+	return deleteUsers(uc.DB, &model.UserSnowflake{}, ids, atomic)
+}
+
+// ExportUsers streams the users matching search to emit with a keyset scan.
+func (uc *GormSnowRepository) ExportUsers(search string, emit func(*model.UserDTO) error) error {
+	key := func(user *model.UserSnowflake) interface{} { return user.ID }
+	return exportUsers(uc.DB, search, key, (*model.UserSnowflake).SnowflakeToDTO, emit)
 }
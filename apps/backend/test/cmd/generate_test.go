@@ -0,0 +1,200 @@
+package cmd_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+
+	"github.com/theCompanyDream/id-trials/apps/backend/cmd"
+	"github.com/theCompanyDream/id-trials/apps/backend/models"
+	"github.com/theCompanyDream/id-trials/apps/backend/test/setup"
+)
+
+// profile is the ID-independent part of a generated user.
+type profile struct {
+	UserName, FirstName, LastName, Email, Department string
+}
+
+// generateDB is a test database that every generator goroutine shares.
+func generateDB(t *testing.T) *gorm.DB {
+	db := setup.NewPostgresMockDB()
+	// Every connection to :memory: is a separate database
+	sqlDB, err := db.DB()
+	require.NoError(t, err)
+	sqlDB.SetMaxOpenConns(1)
+	return db
+}
+
+func generate(t *testing.T, seed int64) *gorm.DB {
+	db := generateDB(t)
+	require.NoError(t, cmd.GenerateData(&models.CmdConfig{RecordsPerTable: 25, BatchSize: 10, OrdersPerUser: 1, Seed: seed}, db))
+	return db
+}
+
+func profiles(t *testing.T, db *gorm.DB, table string) []profile {
+	var rows []profile
+	require.NoError(t, db.Table(table).Order("rowid").
+		Select("user_name, first_name, last_name, email, department").Scan(&rows).Error)
+	return rows
+}
+
+// orderDates lists the creation dates of the orders in table.
+func orderDates(t *testing.T, db *gorm.DB, table string) []time.Time {
+	var dates []time.Time
+	require.NoError(t, db.Table(table).Order("rowid").Pluck("created_at", &dates).Error)
+	for i := range dates {
+		dates[i] = dates[i].UTC()
+	}
+	return dates
+}
+
+func TestGenerateData_SeedReplaysDataset(t *testing.T) {
+	first := generate(t, 42)
+	second := generate(t, 42)
+
+	ulids := profiles(t, first, models.UserUlid{}.TableName())
+	require.Len(t, ulids, 25)
+	for _, table := range []string{
+		models.UserKSUID{}.TableName(),
+		models.UserUUID{}.TableName(),
+		models.UserCUID{}.TableName(),
+		models.UserNanoID{}.TableName(),
+		models.UserSnowflake{}.TableName(),
+	} {
+		assert.Equal(t, ulids, profiles(t, first, table), "every ID type receives the same users: %s", table)
+	}
+	assert.Equal(t, ulids, profiles(t, second, models.UserUlid{}.TableName()), "the same seed replays the same users")
+	orders := orderDates(t, first, models.OrderUUID{}.TableName())
+	require.Len(t, orders, 25)
+	assert.Equal(t, orders, orderDates(t, second, models.OrderUUID{}.TableName()), "the same seed replays the same orders")
+	for _, created := range orders {
+		// Dates come from a fixed window rather than the day of the run
+		assert.True(t, created.Before(time.Date(2025, time.January, 2, 0, 0, 0, 0, time.UTC)), created)
+	}
+
+	var run models.GenerationRun
+	require.NoError(t, first.First(&run).Error)
+	assert.Equal(t, int64(42), run.Seed)
+	assert.Equal(t, "insert", run.Method)
+	assert.Equal(t, models.GenerationCompleted, run.Status)
+	assert.Equal(t, int64(25), run.RecordsPerTable)
+}
+
+func TestGenerateData_SeedsDiffer(t *testing.T) {
+	first := profiles(t, generate(t, 1), models.UserUlid{}.TableName())
+	second := profiles(t, generate(t, 2), models.UserUlid{}.TableName())
+	assert.NotEqual(t, first, second)
+}
+
+func TestGenerateData_ResumesFromCheckpoint(t *testing.T) {
+	config := &models.CmdConfig{RecordsPerTable: 25, BatchSize: 10, OrdersPerUser: 1, Seed: 42}
+	want := profiles(t, generate(t, 42), models.UserUlid{}.TableName())
+
+	// Fail every ULID batch after the first, with an error that is not retried
+	db := generateDB(t)
+	require.NoError(t, db.Exec(`CREATE TRIGGER full_disk BEFORE INSERT ON users_ulid
+		WHEN (SELECT count(*) FROM users_ulid) >= 10
+		BEGIN SELECT RAISE(ABORT, 'disk full'); END`).Error)
+
+	err := cmd.GenerateData(config, db)
+	require.ErrorContains(t, err, "disk full")
+	assert.Len(t, profiles(t, db, models.UserUlid{}.TableName()), 10, "the failed batch is rolled back")
+	assert.Len(t, profiles(t, db, models.UserKSUID{}.TableName()), 25, "other tables finish")
+
+	var checkpoint models.GenerationCheckpoint
+	require.NoError(t, db.Where("id_type = ?", "ULID").First(&checkpoint).Error)
+	assert.Equal(t, int64(10), checkpoint.Completed)
+
+	require.NoError(t, db.Exec("DROP TRIGGER full_disk").Error)
+	require.NoError(t, cmd.GenerateData(&models.CmdConfig{Resume: "latest"}, db))
+
+	assert.Equal(t, want, profiles(t, db, models.UserUlid{}.TableName()), "the resumed run continues the seeded sequence")
+	var orders int64
+	require.NoError(t, db.Model(&models.OrderUlid{}).Count(&orders).Error)
+	assert.Equal(t, int64(25), orders)
+
+	var run models.GenerationRun
+	require.NoError(t, db.First(&run).Error)
+	assert.Equal(t, models.GenerationCompleted, run.Status)
+
+	assert.Error(t, cmd.GenerateData(&models.CmdConfig{Resume: "latest"}, db), "nothing is left to resume")
+}
+
+func count(t *testing.T, db *gorm.DB, model interface{}) int64 {
+	var rows int64
+	require.NoError(t, db.Model(model).Count(&rows).Error)
+	return rows
+}
+
+func TestGenerateData_SelectedTypes(t *testing.T) {
+	db := generateDB(t)
+	config := &models.CmdConfig{RecordsPerTable: 5, BatchSize: 5, Seed: 1, IDTypes: []string{"ulid", "Snowflake"}}
+	require.NoError(t, cmd.GenerateData(config, db))
+
+	assert.Equal(t, int64(5), count(t, db, &models.UserUlid{}))
+	assert.Equal(t, int64(5), count(t, db, &models.UserSnowflake{}))
+	assert.Equal(t, int64(0), count(t, db, &models.UserKSUID{}))
+
+	var run models.GenerationRun
+	require.NoError(t, db.First(&run).Error)
+	assert.Equal(t, "ulid,Snowflake", run.Types)
+
+	config.IDTypes = []string{"guid"}
+	assert.ErrorContains(t, cmd.GenerateData(config, db), `unknown ID type "guid"`)
+}
+
+func TestResetData_DropResetsWholeSchema(t *testing.T) {
+	db := generateDB(t)
+	err := cmd.ResetData(&models.CmdConfig{IDTypes: []string{"ulid"}, Drop: true}, db)
+	assert.ErrorContains(t, err, "cannot be combined with --types")
+	err = cmd.ResetData(&models.CmdConfig{Drop: true, KeepMetrics: true}, db)
+	assert.ErrorContains(t, err, "--keep-metrics")
+}
+
+// sqlRecorder records the statements of a dry-run session.
+type sqlRecorder struct {
+	logger.Interface
+	statements []string
+}
+
+func (r *sqlRecorder) Trace(_ context.Context, _ time.Time, fc func() (string, int64), _ error) {
+	sql, _ := fc()
+	r.statements = append(r.statements, sql)
+}
+
+func TestResetData_TruncatesPartitionedTables(t *testing.T) {
+	recorder := &sqlRecorder{Interface: logger.Discard}
+	db := generateDB(t).Session(&gorm.Session{DryRun: true, Logger: recorder})
+
+	require.NoError(t, cmd.ResetData(&models.CmdConfig{IDTypes: []string{"ulid", "cuid2"}, KeepMetrics: true}, db))
+	require.Len(t, recorder.statements, 1)
+	truncate := recorder.statements[0]
+	for _, table := range []string{"orders_ulid", "users_ulid", "users_ulid_part", "orders_cuid", "users_cuid"} {
+		assert.Contains(t, truncate, "`"+table+"`")
+	}
+	assert.NotContains(t, truncate, "users_ksuid_part")
+	assert.NotContains(t, truncate, "route_metrics")
+}
+
+func TestParseSize(t *testing.T) {
+	for size, bytes := range map[string]int64{
+		"10GB":   10 << 30,
+		"512 MB": 512 << 20,
+		"1.5tb":  3 << 39,
+		"64KiB":  64 << 10,
+		"4096":   4096,
+	} {
+		parsed, err := cmd.ParseSize(size)
+		require.NoError(t, err, size)
+		assert.Equal(t, bytes, parsed, size)
+	}
+	for _, size := range []string{"", "GB", "-1GB", "ten GB"} {
+		_, err := cmd.ParseSize(size)
+		assert.Error(t, err, size)
+	}
+}
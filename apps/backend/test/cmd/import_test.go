@@ -0,0 +1,131 @@
+package cmd_test
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/bwmarrin/snowflake"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+
+	"github.com/theCompanyDream/id-trials/apps/backend/cmd"
+	"github.com/theCompanyDream/id-trials/apps/backend/models"
+	"github.com/theCompanyDream/id-trials/apps/backend/test/setup"
+)
+
+func importTargets(t *testing.T, types ...string) []cmd.ImportTarget {
+	node, err := snowflake.NewNode(1)
+	require.NoError(t, err)
+	targets, err := cmd.SelectTargets(cmd.ImportTargets(node), types)
+	require.NoError(t, err)
+	return targets
+}
+
+func TestImportUsers_RegeneratesIDs(t *testing.T) {
+	db := setup.NewPostgresMockDB()
+	input := strings.NewReader(`{"id":"source-1","user_name":"ada","first_name":"Ada","last_name":"Lovelace","email":"ada@example.com"}
+{"id":"source-2","user_name":"grace","first_name":"Grace","last_name":"Hopper","email":"grace@example.com","department":"Navy"}
+{"id":"source-3","user_name":"nobody","first_name":"No","last_name":"Email"}
+`)
+	var mapping bytes.Buffer
+
+	report, err := cmd.ImportUsers(db, input, "ndjson", importTargets(t, "ulid", "Snowflake"), false, 1, &mapping)
+	require.NoError(t, err)
+	assert.Equal(t, 3, report.Read)
+	assert.Equal(t, 1, report.Invalid, "records without an email are skipped")
+	assert.Equal(t, 2, report.Inserted["ULID"])
+	assert.Equal(t, 2, report.Inserted["Snowflake"])
+
+	var users []models.UserSnowflake
+	require.NoError(t, db.Order("id").Find(&users).Error)
+	require.Len(t, users, 2)
+	assert.Equal(t, "Navy", *users[1].Department)
+
+	rows, err := csv.NewReader(&mapping).ReadAll()
+	require.NoError(t, err)
+	require.Len(t, rows, 5, "a header and one row per regenerated ID and type")
+	assert.Equal(t, []string{"id_type", "line", "source_id", "new_id"}, rows[0])
+	assert.Equal(t, "source-1", rows[1][2])
+}
+
+func TestImportUsers_PreservesIDs(t *testing.T) {
+	db := setup.NewPostgresMockDB()
+	file := "id,user_name,first_name,last_name,email,department\n" +
+		"01HZX3K4Q2M8V6T9R5N7B1C0DE,ada,Ada,Lovelace,ada@example.com,\n" +
+		"not-a-ulid,grace,Grace,Hopper,grace@example.com,Navy\n"
+
+	report, err := cmd.ImportUsers(db, strings.NewReader(file), "csv", importTargets(t, "ULID"), true, 100, nil)
+	require.NoError(t, err)
+	assert.Equal(t, 1, report.Inserted["ULID"])
+	assert.Equal(t, 1, report.Skipped["ULID"], "IDs invalid for the type are skipped")
+
+	var user models.UserUlid
+	require.NoError(t, db.Where("id = ?", "01HZX3K4Q2M8V6T9R5N7B1C0DE").First(&user).Error)
+	assert.Equal(t, "ada", user.UserName)
+	assert.Nil(t, user.Department)
+
+	// Replaying the file leaves the existing rows alone
+	report, err = cmd.ImportUsers(db, strings.NewReader(file), "csv", importTargets(t, "ULID"), true, 100, nil)
+	require.NoError(t, err)
+	assert.Equal(t, 0, report.Inserted["ULID"])
+}
+
+// TestImportUsers_ChunksLargeBatches checks that a batch is written in chunks,
+// so a large --batch stays below the bind parameter limit of one statement.
+func TestImportUsers_ChunksLargeBatches(t *testing.T) {
+	var file strings.Builder
+	for i := 0; i < 2500; i++ {
+		fmt.Fprintf(&file, `{"user_name":"user%d","first_name":"First","last_name":"Last","email":"user%d@example.com"}`+"\n", i, i)
+	}
+	recorder := &sqlRecorder{Interface: logger.Discard}
+	db := setup.NewPostgresMockDB().Session(&gorm.Session{Logger: recorder})
+
+	report, err := cmd.ImportUsers(db, strings.NewReader(file.String()), "ndjson", importTargets(t, "KSUID"), false, 5000, nil)
+	require.NoError(t, err)
+	assert.Equal(t, 2500, report.Inserted["KSUID"])
+	assert.Equal(t, int64(2500), count(t, db, &models.UserKSUID{}))
+
+	inserts := 0
+	for _, statement := range recorder.statements {
+		if strings.HasPrefix(statement, "INSERT INTO") {
+			inserts++
+		}
+	}
+	assert.Equal(t, 3, inserts, "one INSERT per 1000 rows")
+}
+
+func TestSelectTargets_UnknownType(t *testing.T) {
+	node, err := snowflake.NewNode(1)
+	require.NoError(t, err)
+	_, err = cmd.SelectTargets(cmd.ImportTargets(node), []string{"guid"})
+	assert.Error(t, err)
+}
+
+func TestSelectTargets_UUIDByName(t *testing.T) {
+	for _, name := range []string{"UUID", "uuid4"} {
+		targets := importTargets(t, name)
+		require.Len(t, targets, 1)
+		assert.Equal(t, "UUID", targets[0].Name, "named like the metrics and the other commands")
+	}
+}
+
+func TestImportData_ReturnsErrors(t *testing.T) {
+	db := setup.NewPostgresMockDB()
+	err := cmd.ImportData(&models.CmdConfig{ImportFile: filepath.Join(t.TempDir(), "missing.ndjson")}, db)
+	assert.ErrorContains(t, err, "missing.ndjson")
+
+	file := filepath.Join(t.TempDir(), "users.txt")
+	require.NoError(t, os.WriteFile(file, []byte("{}\n"), 0o644))
+	err = cmd.ImportData(&models.CmdConfig{ImportFile: file, ImportFormat: "xml"}, db)
+	assert.ErrorContains(t, err, `unknown import format "xml"`)
+
+	err = cmd.ImportData(&models.CmdConfig{ImportFile: file, IDTypes: []string{"guid"}}, db)
+	assert.ErrorContains(t, err, `unknown ID type "guid"`)
+}
@@ -0,0 +1,213 @@
+package config_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/spf13/pflag"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/theCompanyDream/id-trials/apps/backend/config"
+)
+
+// clearEnv unsets every variable Load reads for the rest of the test.
+func clearEnv(t *testing.T) {
+	for _, name := range []string{
+		config.EnvConfigFile, "BACKEND_HOST", "BACKEND_PORT", "GRPC_PORT", "ALLOWED_HOSTS", "DRAIN_DELAY", "DRAIN_TIMEOUT", "POSTGRES_URL",
+		"DATABASE_HOST", "DATABASE_PORT", "DATABASE_USERNAME", "DATABASE_PASSWORD", "DATABASE_NAME", "DATABASE_SSLMODE",
+		"DATABASE_MAX_OPEN_CONNS", "DATABASE_MAX_IDLE_CONNS", "DATABASE_CONN_MAX_LIFETIME", "DATABASE_CONN_MAX_IDLE_TIME",
+		"BODY_LIMIT", "BATCH_BODY_LIMIT", "BATCH_MAX_ITEMS", "RATE_LIMIT", "EXPLAIN_ANALYZE", "SEARCH_MODE", "PARTITIONED_STORAGE", "POOL_SAMPLE_INTERVAL", "PPROF",
+	} {
+		t.Setenv(name, "")
+	}
+}
+
+func writeFile(t *testing.T, content string) string {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	return path
+}
+
+func serverFlags(t *testing.T, args ...string) *pflag.FlagSet {
+	flags := pflag.NewFlagSet("server", pflag.ContinueOnError)
+	flags.IntP("port", "p", 3000, "")
+	flags.StringP("host", "H", "", "")
+	flags.StringP("database", "d", "", "")
+	require.NoError(t, flags.Parse(args))
+	return flags
+}
+
+func TestLoadDefaults(t *testing.T) {
+	clearEnv(t)
+	cfg, err := config.Load("", nil)
+	require.NoError(t, err)
+	assert.Equal(t, config.Default(), cfg)
+	assert.Equal(t, ":3000", cfg.Server.Address())
+	assert.Equal(t, "host=localhost port=5432 user= password= dbname= sslmode=disable TimeZone=UTC", cfg.Database.DSN())
+}
+
+func TestLoadPrecedence(t *testing.T) {
+	clearEnv(t)
+	path := writeFile(t, `
+server:
+  host: 0.0.0.0
+  port: 8000
+  allowed_origins: [https://a.example]
+database:
+  host: db
+  name: ids
+limits:
+  batch_max_items: 50
+search:
+  mode: trigram
+`)
+	t.Setenv("BACKEND_PORT", "9000")
+	t.Setenv("ALLOWED_HOSTS", "https://a.example, https://b.example")
+	t.Setenv("SEARCH_MODE", "fulltext")
+	t.Setenv("PARTITIONED_STORAGE", "true")
+
+	cfg, err := config.Load(path, serverFlags(t, "--port", "9100"))
+	require.NoError(t, err)
+
+	// Flag over env over file over default
+	assert.Equal(t, 9100, cfg.Server.Port)
+	assert.Equal(t, "0.0.0.0:9100", cfg.Server.Address())
+	assert.Equal(t, []string{"https://a.example", "https://b.example"}, cfg.Server.AllowedOrigins)
+	assert.Equal(t, config.SearchModeFullText, cfg.Search.Mode)
+	assert.True(t, cfg.Storage.Partitioned)
+	assert.Equal(t, 50, cfg.Limits.BatchMaxItems)
+	assert.Equal(t, "db", cfg.Database.Host)
+	assert.Equal(t, 5432, cfg.Database.Port)
+	assert.Equal(t, "20K", cfg.Limits.BodyLimit)
+}
+
+func TestLoadFileFromEnv(t *testing.T) {
+	clearEnv(t)
+	t.Setenv(config.EnvConfigFile, writeFile(t, "metrics:\n  explain_analyze: true\n"))
+
+	cfg, err := config.Load("", nil)
+	require.NoError(t, err)
+	assert.True(t, cfg.Metrics.ExplainAnalyze)
+}
+
+func TestLoadDatabaseURL(t *testing.T) {
+	clearEnv(t)
+	t.Setenv("POSTGRES_URL", "postgres://env@db/ids")
+
+	cfg, err := config.Load("", serverFlags(t))
+	require.NoError(t, err)
+	assert.Equal(t, "postgres://env@db/ids", cfg.Database.DSN())
+
+	cfg, err = config.Load("", serverFlags(t, "-d", "postgres://flag@db/ids"))
+	require.NoError(t, err)
+	assert.Equal(t, "postgres://flag@db/ids", cfg.Database.DSN())
+}
+
+func TestLoadPoolSettings(t *testing.T) {
+	clearEnv(t)
+	path := writeFile(t, `
+database:
+  max_open_conns: 8
+  max_idle_conns: 4
+  conn_max_lifetime: 1h
+metrics:
+  pool_sample_interval: 0s
+`)
+	t.Setenv("DATABASE_CONN_MAX_IDLE_TIME", "90s")
+
+	cfg, err := config.Load(path, nil)
+	require.NoError(t, err)
+	assert.Equal(t, 8, cfg.Database.MaxOpenConns)
+	assert.Equal(t, 4, cfg.Database.MaxIdleConns)
+	assert.Equal(t, time.Hour, cfg.Database.ConnMaxLifetime)
+	assert.Equal(t, 90*time.Second, cfg.Database.ConnMaxIdleTime)
+	assert.Zero(t, cfg.Metrics.PoolSampleInterval)
+
+	t.Setenv("DATABASE_CONN_MAX_IDLE_TIME", "90")
+	_, err = config.Load(path, nil)
+	assert.ErrorContains(t, err, "DATABASE_CONN_MAX_IDLE_TIME")
+
+	t.Setenv("DATABASE_CONN_MAX_IDLE_TIME", "")
+	t.Setenv("DATABASE_MAX_IDLE_CONNS", "16")
+	_, err = config.Load(path, nil)
+	assert.ErrorContains(t, err, "database.max_idle_conns must not exceed")
+}
+
+func TestLoadRejectsBadInput(t *testing.T) {
+	clearEnv(t)
+
+	_, err := config.Load(writeFile(t, "server:\n  prot: 80\n"), nil)
+	assert.ErrorContains(t, err, "field prot not found")
+
+	_, err = config.Load(filepath.Join(t.TempDir(), "missing.yaml"), nil)
+	assert.ErrorContains(t, err, "read config file")
+
+	t.Setenv("BACKEND_PORT", "http")
+	_, err = config.Load("", nil)
+	assert.ErrorContains(t, err, "BACKEND_PORT")
+}
+
+func TestValidate(t *testing.T) {
+	cfg := config.Default()
+	cfg.Server.Port = 0
+	cfg.Server.GRPCPort = -1
+	cfg.Server.DrainTimeout = 0
+	cfg.Database.SSLMode = "sometimes"
+	cfg.Limits.BodyLimit = "lots"
+	cfg.Limits.BatchMaxItems = 0
+	cfg.Limits.RateLimit = -1
+	cfg.Search.Mode = "regex"
+
+	err := cfg.Validate()
+	require.Error(t, err)
+	for _, key := range []string{"server.port", "server.grpc_port", "server.drain_timeout", "database.sslmode", "limits.body_limit", "limits.batch_max_items", "limits.rate_limit", "search.mode"} {
+		assert.ErrorContains(t, err, key)
+	}
+
+	// The gRPC server needs a port of its own, unless it is disabled
+	cfg = config.Default()
+	cfg.Server.GRPCPort = cfg.Server.Port
+	assert.ErrorContains(t, cfg.Validate(), "server.grpc_port must differ from server.port")
+	cfg.Server.GRPCPort = 0
+	assert.NoError(t, cfg.Validate())
+
+	// The parts of the database are not used once a URL is set
+	cfg = config.Default()
+	cfg.Database = config.Database{URL: "postgres://db/ids"}
+	assert.NoError(t, cfg.Validate())
+}
+
+func TestRedacted(t *testing.T) {
+	cfg := config.Default()
+	cfg.Database.Password = "hunter2"
+	cfg.Server.AllowedOrigins = []string{"https://a.example"}
+
+	for url, want := range map[string]string{
+		"postgres://bench:hunter2@db:5432/ids": "postgres://bench:xxxxx@db:5432/ids",
+		"postgres://bench@db/ids":              "postgres://bench@db/ids",
+		"host=db password=hunter2 dbname=ids":  "host=db password=xxxxx dbname=ids",
+		"host=db password='hun ter2' user=b":   "host=db password=xxxxx user=b",
+	} {
+		cfg.Database.URL = url
+		redacted := cfg.Redacted()
+		assert.Equal(t, want, redacted.Database.URL)
+		assert.Equal(t, "xxxxx", redacted.Database.Password)
+	}
+
+	// The original is untouched
+	assert.Equal(t, "hunter2", cfg.Database.Password)
+	cfg.Redacted().Server.AllowedOrigins[0] = "changed"
+	assert.Equal(t, "https://a.example", cfg.Server.AllowedOrigins[0])
+
+	// The DSN printed on connect carries no password either
+	cfg.Database.URL = ""
+	assert.NotContains(t, cfg.Database.Redacted().DSN(), "hunter2")
+	assert.Contains(t, cfg.Database.Redacted().DSN(), "password=xxxxx")
+
+	summary := cfg.Summary()
+	assert.Equal(t, "xxxxx", summary["database"].(map[string]interface{})["password"])
+	assert.Equal(t, 3000, summary["server"].(map[string]interface{})["port"])
+}
@@ -0,0 +1,351 @@
+package contract_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/go-openapi/loads"
+	"github.com/go-openapi/spec"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/validate"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/theCompanyDream/id-trials/apps/backend/config"
+	"github.com/theCompanyDream/id-trials/apps/backend/controller"
+	"github.com/theCompanyDream/id-trials/apps/backend/docs"
+	"github.com/theCompanyDream/id-trials/apps/backend/test/setup"
+)
+
+// Bodies sent to the operations, by the name of their body parameter.
+var (
+	userBody = map[string]interface{}{
+		"user_name":  "contract",
+		"first_name": "Contract",
+		"last_name":  "Tester",
+		"email":      "contract@example.com",
+		"department": "Quality",
+	}
+	batchUserBody = map[string]interface{}{
+		"user_name":  "contractbatch",
+		"first_name": "Batch",
+		"last_name":  "Tester",
+		"email":      "batch@example.com",
+	}
+	orderBody = map[string]interface{}{
+		"product":     "Widget",
+		"quantity":    2,
+		"total_cents": 1999,
+		"status":      "pending",
+	}
+)
+
+// postgresOnly are the operations whose queries SQLite cannot run. Their
+// responses are still checked against the spec, but need not succeed.
+var postgresOnly = map[string]bool{
+	"GET /analytics/conditionalWrites":  true,
+	"GET /analytics/details/{type}":     true,
+	"GET /analytics/errors/{type}":      true,
+	"GET /analytics/foreignKeys":        true,
+	"GET /analytics/idEfficiency":       true,
+	"GET /analytics/pool":               true,
+	"GET /analytics/pool/timeline":      true,
+	"GET /analytics/protocols":          true,
+	"GET /analytics/search":             true,
+	"GET /analytics/tableSize":          true,
+	"GET /analytics/trend/{type}":       true,
+	"GET /analytics/writeAmplification": true,
+}
+
+// operation is one method of a documented path.
+type operation struct {
+	method string
+	path   string
+	*spec.Operation
+}
+
+func (o operation) String() string {
+	return o.method + " " + o.path
+}
+
+// loadSpec loads the generated spec with every $ref resolved.
+func loadSpec(t *testing.T) *loads.Document {
+	doc, err := loads.Analyzed(json.RawMessage(docs.SwaggerInfo.ReadDoc()), "")
+	require.NoError(t, err)
+	doc, err = doc.Expanded()
+	require.NoError(t, err)
+	return doc
+}
+
+// operations lists every documented operation, sorted by path and method.
+func operations(t *testing.T) []operation {
+	var ops []operation
+	for path, item := range loadSpec(t).Spec().Paths.Paths {
+		for method, op := range map[string]*spec.Operation{
+			http.MethodGet:    item.Get,
+			http.MethodPost:   item.Post,
+			http.MethodPut:    item.Put,
+			http.MethodPatch:  item.Patch,
+			http.MethodDelete: item.Delete,
+		} {
+			if op != nil {
+				ops = append(ops, operation{method: method, path: path, Operation: op})
+			}
+		}
+	}
+	require.NotEmpty(t, ops)
+	sort.Slice(ops, func(i, j int) bool { return ops[i].String() < ops[j].String() })
+	return ops
+}
+
+func newServer(t *testing.T) *controller.Server {
+	cfg := config.Default()
+	cfg.Limits.RateLimit = 0
+	cfg.Metrics.PoolSampleInterval = 0
+	db := setup.NewPostgresMockDB()
+	setup.MarkMigrated(t, db)
+	// Every connection to :memory: is a separate database, and metrics are
+	// written next to the request
+	sqlDB, err := db.DB()
+	require.NoError(t, err)
+	sqlDB.SetMaxOpenConns(1)
+	return controller.NewEchoServer(db, cfg)
+}
+
+// fixture is a user and one of its orders, of the ID type an operation is for.
+type fixture struct {
+	user, order string
+}
+
+// call serves one JSON request and returns the recorder.
+func call(server http.Handler, method, path string, body interface{}) *httptest.ResponseRecorder {
+	var payload []byte
+	if body != nil {
+		payload, _ = json.Marshal(body)
+	}
+	req := httptest.NewRequest(method, path, bytes.NewReader(payload))
+	if body != nil {
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	}
+	rec := httptest.NewRecorder()
+	server.ServeHTTP(rec, req)
+	return rec
+}
+
+// createdID returns the id of a created resource, keeping big numeric IDs
+// such as snowflakes intact.
+func createdID(t *testing.T, rec *httptest.ResponseRecorder) string {
+	require.Equal(t, http.StatusCreated, rec.Code, rec.Body.String())
+	var created map[string]interface{}
+	decoder := json.NewDecoder(bytes.NewReader(rec.Body.Bytes()))
+	decoder.UseNumber()
+	require.NoError(t, decoder.Decode(&created))
+	return fmt.Sprint(created["id"])
+}
+
+// newFixture creates a user and an order through the API when path belongs
+// to an ID type.
+func newFixture(t *testing.T, server http.Handler, path string) fixture {
+	rest, ok := strings.CutPrefix(path, controller.APIPrefix+"/ids/")
+	if !ok {
+		return fixture{}
+	}
+	slug, _, _ := strings.Cut(rest, "/")
+	users := controller.APIPrefix + "/ids/" + slug + "/users"
+
+	var fx fixture
+	fx.user = createdID(t, call(server, http.MethodPost, users, userBody))
+	fx.order = createdID(t, call(server, http.MethodPost, users+"/"+fx.user+"/orders", orderBody))
+	return fx
+}
+
+// newRequest builds a request for o from its parameters, using id in place
+// of a valid {id} when it is not empty.
+func newRequest(t *testing.T, o operation, fx fixture, id string) *http.Request {
+	path := o.path
+	var body interface{}
+	for _, param := range o.Parameters {
+		switch param.In {
+		case "path":
+			var value string
+			switch {
+			case param.Name == "id" && id != "":
+				value = id
+			case param.Name == "id" && strings.Contains(o.path, "/orders/{id}"):
+				value = fx.order
+			case param.Name == "id":
+				value = fx.user
+			case len(param.Enum) > 0:
+				value = fmt.Sprint(param.Enum[0])
+			default:
+				t.Fatalf("%s: no value for path parameter %s", o, param.Name)
+			}
+			path = strings.ReplaceAll(path, "{"+param.Name+"}", value)
+		case "body":
+			switch param.Name {
+			case "user":
+				body = userBody
+			case "users":
+				body = map[string]interface{}{"users": []interface{}{batchUserBody}}
+			case "ids":
+				body = map[string]interface{}{"ids": []string{fx.user}}
+			case "order":
+				body = orderBody
+			default:
+				t.Fatalf("%s: no value for body parameter %s", o, param.Name)
+			}
+		}
+	}
+
+	var payload []byte
+	if body != nil {
+		payload, _ = json.Marshal(body)
+	}
+	req := httptest.NewRequest(o.method, path, bytes.NewReader(payload))
+	if body != nil {
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	}
+	return req
+}
+
+// successStatus is the lowest 2xx status o documents.
+func successStatus(t *testing.T, o operation) int {
+	status := 0
+	for code := range o.Responses.StatusCodeResponses {
+		if code >= 200 && code < 300 && (status == 0 || code < status) {
+			status = code
+		}
+	}
+	require.NotZero(t, status, "%s documents no success response", o)
+	return status
+}
+
+// nullable marks the schemas swag tags x-nullable as Nullable, which is what
+// the validator reads.
+func nullable(schema *spec.Schema) {
+	if schema == nil {
+		return
+	}
+	if isNullable, _ := schema.Extensions.GetBool("x-nullable"); isNullable {
+		schema.Nullable = true
+	}
+	properties := make(spec.SchemaProperties, len(schema.Properties))
+	for name, property := range schema.Properties {
+		nullable(&property)
+		properties[name] = property
+	}
+	schema.Properties = properties
+	allOf := append([]spec.Schema(nil), schema.AllOf...)
+	for i := range allOf {
+		nullable(&allOf[i])
+	}
+	schema.AllOf = allOf
+	if schema.Items != nil && schema.Items.Schema != nil {
+		items := *schema.Items.Schema
+		nullable(&items)
+		schema.Items = &spec.SchemaOrArray{Schema: &items}
+	}
+	if schema.AdditionalProperties != nil && schema.AdditionalProperties.Schema != nil {
+		additional := *schema.AdditionalProperties.Schema
+		nullable(&additional)
+		schema.AdditionalProperties = &spec.SchemaOrBool{Allows: true, Schema: &additional}
+	}
+}
+
+// checkResponse fails unless the status of rec is documented for o and its
+// body matches the documented schema.
+func checkResponse(t *testing.T, o operation, rec *httptest.ResponseRecorder) {
+	response, ok := o.Responses.StatusCodeResponses[rec.Code]
+	if !assert.True(t, ok, "%s returned undocumented status %d: %s", o, rec.Code, rec.Body.String()) {
+		return
+	}
+	if response.Schema == nil {
+		assert.Empty(t, rec.Body.String(), "%s %d documents no body", o, rec.Code)
+		return
+	}
+	if !assert.NotEmpty(t, rec.Body.String(), "%s %d documents a body", o, rec.Code) {
+		return
+	}
+
+	// Streams such as NDJSON and CSV are documented as strings
+	contentType := rec.Header().Get(echo.HeaderContentType)
+	if !strings.HasPrefix(contentType, echo.MIMEApplicationJSON) && !strings.HasPrefix(contentType, "application/problem+json") {
+		assert.True(t, response.Schema.Type.Contains("string"), "%s %d returned %s, not JSON", o, rec.Code, contentType)
+		return
+	}
+
+	// Numbers stay exact, as snowflakes exceed float64 precision
+	var body interface{}
+	decoder := json.NewDecoder(bytes.NewReader(rec.Body.Bytes()))
+	decoder.UseNumber()
+	require.NoError(t, decoder.Decode(&body), "%s %d", o, rec.Code)
+	schema := *response.Schema
+	nullable(&schema)
+	err := validate.AgainstSchema(&schema, body, strfmt.Default)
+	assert.NoError(t, err, "%s %d body does not match its schema: %s", o, rec.Code, rec.Body.String())
+}
+
+func TestContract_EveryOperation(t *testing.T) {
+	for _, o := range operations(t) {
+		t.Run(o.String(), func(t *testing.T) {
+			server := newServer(t)
+			fx := newFixture(t, server, o.path)
+
+			rec := httptest.NewRecorder()
+			server.ServeHTTP(rec, newRequest(t, o, fx, ""))
+			if !postgresOnly[o.String()] {
+				assert.Equal(t, successStatus(t, o), rec.Code, rec.Body.String())
+			}
+			checkResponse(t, o, rec)
+		})
+	}
+}
+
+func TestContract_InvalidIDs(t *testing.T) {
+	server := newServer(t)
+	for _, o := range operations(t) {
+		if !strings.Contains(o.path, "{id}") {
+			continue
+		}
+		t.Run(o.String(), func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			server.ServeHTTP(rec, newRequest(t, o, fixture{}, "not-an-id"))
+			assert.Equal(t, http.StatusBadRequest, rec.Code, rec.Body.String())
+			checkResponse(t, o, rec)
+		})
+	}
+}
+
+var pathParam = regexp.MustCompile(`\{(\w+)\}`)
+
+func TestContract_OperationsAreServed(t *testing.T) {
+	served := map[string]bool{}
+	for _, route := range newServer(t).Routes() {
+		served[route.Method+" "+route.Path] = true
+	}
+
+	for _, o := range operations(t) {
+		route := o.method + " " + pathParam.ReplaceAllString(o.path, ":$1")
+		assert.True(t, served[route], "%s is documented but not served", o)
+
+		// Path parameters are exactly those of the path
+		var documented, inPath []string
+		for _, param := range o.Parameters {
+			if param.In == "path" {
+				documented = append(documented, param.Name)
+				assert.True(t, param.Required, "%s: path parameter %s must be required", o, param.Name)
+			}
+		}
+		for _, match := range pathParam.FindAllStringSubmatch(o.path, -1) {
+			inPath = append(inPath, match[1])
+		}
+		assert.ElementsMatch(t, inPath, documented, "%s path parameters", o)
+	}
+}
@@ -0,0 +1,36 @@
+package controller_test
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/theCompanyDream/id-trials/apps/backend/models"
+	"github.com/theCompanyDream/id-trials/apps/backend/models/stats"
+	"github.com/theCompanyDream/id-trials/apps/backend/repository"
+	"github.com/theCompanyDream/id-trials/apps/backend/test/setup"
+)
+
+func TestGetWriteAmplification(t *testing.T) {
+	db := setup.NewPostgresMockDB()
+	server := healthServer(t, db, nil)
+
+	var results []stats.WriteAmplification
+	assert.Equal(t, http.StatusOK, get(server, "/analytics/writeAmplification", &results))
+	assert.Empty(t, results, "no benchmark has run")
+
+	require.NoError(t, repository.NewBenchmarkRepository(db).SaveWriteBenchmark(&models.WriteBenchmark{
+		RunID: "run-1", IDType: "KSUID", Table: "users_ksuid", Records: 50,
+		WalBytes: 5000, WalFpi: 5, IndexPagesBefore: 2, IndexPagesAfter: 3,
+		IndexBlocksHit: 40, HeapBlocksHit: 10, Duration: 3, Timestamp: time.Now(),
+	}))
+	assert.Equal(t, http.StatusOK, get(server, "/analytics/writeAmplification", &results))
+	require.Len(t, results, 1)
+	assert.Equal(t, "KSUID", results[0].IDType)
+	assert.InDelta(t, 100.0, results[0].WalBytesPerInsert, 0.001)
+	assert.InDelta(t, 0.1, results[0].WalFpiPerInsert, 0.0001)
+	assert.Equal(t, int64(1), results[0].IndexPagesAdded)
+	assert.InDelta(t, 100.0, results[0].BufferHitRatio, 0.001)
+}
@@ -0,0 +1,151 @@
+package controller_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/theCompanyDream/id-trials/apps/backend/config"
+	"github.com/theCompanyDream/id-trials/apps/backend/controller"
+	"github.com/theCompanyDream/id-trials/apps/backend/test/setup"
+)
+
+// routeSet lists the method and path of every route, without prefix. The
+// versioned API is served under controller.APIPrefix by both servers.
+func routeSet(server *echo.Echo, prefix string) []string {
+	var routes []string
+	for _, route := range server.Routes() {
+		path := route.Path
+		if !strings.HasPrefix(path, controller.APIPrefix) {
+			path = strings.TrimPrefix(path, prefix)
+		}
+		routes = append(routes, route.Method+" "+path)
+	}
+	sort.Strings(routes)
+	return routes
+}
+
+func TestServers_ExposeTheSameRoutes(t *testing.T) {
+	db := setup.NewPostgresMockDB()
+	cfg := config.Default()
+	cfg.Debug.Pprof = true
+
+	server := controller.NewEchoServer(db, cfg)
+	serverless := controller.NewServerlessEchoServer(db, cfg)
+
+	routes := routeSet(server.Echo, "")
+	assert.NotEmpty(t, routes)
+	assert.Contains(t, routes, "GET /ulidId/:id")
+	assert.Contains(t, routes, "GET /api/v1/ids/ulid/users/:id")
+	assert.Equal(t, routes, routeSet(serverless, "/api"))
+	for _, route := range serverless.Routes() {
+		assert.True(t, strings.HasPrefix(route.Path, "/api/"), route.Path)
+	}
+}
+
+func TestServers_ShareErrorHandling(t *testing.T) {
+	db := setup.NewPostgresMockDB()
+	cfg := config.Default()
+
+	for path, server := range map[string]http.Handler{
+		"/ulidId/not-an-id":     controller.NewEchoServer(db, cfg),
+		"/api/ulidId/not-an-id": controller.NewServerlessEchoServer(db, cfg),
+	} {
+		rec := httptest.NewRecorder()
+		server.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, path, nil))
+		assert.Equal(t, http.StatusBadRequest, rec.Code, path)
+		assert.Contains(t, rec.Header().Get(echo.HeaderContentType), "application/problem+json", path)
+	}
+}
+
+var v1Types = []string{"ulid", "uuid", "nanoid", "ksuid", "cuid", "snowflake"}
+
+func TestV1_SameRoutesForEveryIDType(t *testing.T) {
+	server := controller.NewEchoServer(setup.NewPostgresMockDB(), config.Default())
+
+	byType := map[string][]string{}
+	for _, route := range server.Routes() {
+		rest, ok := strings.CutPrefix(route.Path, controller.APIPrefix+"/ids/")
+		if !ok {
+			continue
+		}
+		idType, path, _ := strings.Cut(rest, "/")
+		byType[idType] = append(byType[idType], route.Method+" /"+path)
+	}
+
+	assert.Len(t, byType, len(v1Types))
+	want := byType["ulid"]
+	sort.Strings(want)
+	assert.Len(t, want, 15)
+	for _, idType := range v1Types {
+		got := byType[idType]
+		sort.Strings(got)
+		assert.Equal(t, want, got, idType)
+	}
+}
+
+func TestV1_ServesEveryIDType(t *testing.T) {
+	for _, idType := range v1Types {
+		t.Run(idType, func(t *testing.T) {
+			cfg := config.Default()
+			cfg.Limits.RateLimit = 0
+			db := setup.NewPostgresMockDB()
+			sqlDB, err := db.DB()
+			require.NoError(t, err)
+			sqlDB.SetMaxOpenConns(1)
+			server := controller.NewEchoServer(db, cfg)
+			users := controller.APIPrefix + "/ids/" + idType + "/users"
+
+			body, _ := json.Marshal(replacement)
+			req := httptest.NewRequest(http.MethodPost, users, bytes.NewReader(body))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			server.ServeHTTP(rec, req)
+			require.Equal(t, http.StatusCreated, rec.Code, rec.Body.String())
+			var created map[string]interface{}
+			decoder := json.NewDecoder(rec.Body)
+			decoder.UseNumber()
+			require.NoError(t, decoder.Decode(&created))
+
+			rec = httptest.NewRecorder()
+			server.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, users+"/"+fmt.Sprint(created["id"]), nil))
+			assert.Equal(t, http.StatusOK, rec.Code)
+			assert.Empty(t, rec.Header().Get("Deprecation"))
+
+			rec = httptest.NewRecorder()
+			server.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, users, nil))
+			assert.Equal(t, http.StatusOK, rec.Code)
+			assert.Contains(t, rec.Body.String(), "replaced@example.com")
+		})
+	}
+}
+
+func TestLegacyRoutes_AreDeprecated(t *testing.T) {
+	cfg := config.Default()
+	cfg.Limits.RateLimit = 0
+	server := controller.NewEchoServer(setup.NewPostgresMockDB(), cfg)
+
+	for path, successor := range map[string]string{
+		"/ulidIds":             "/api/v1/ids/ulid/users",
+		"/uuid4/not-an-id":     "/api/v1/ids/uuid/users",
+		"/snowOrder/not-an-id": "/api/v1/ids/snowflake/users",
+	} {
+		rec := httptest.NewRecorder()
+		server.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, path, nil))
+		assert.Equal(t, fmt.Sprintf("@%d", controller.LegacyDeprecated.Unix()), rec.Header().Get("Deprecation"), path)
+		assert.Equal(t, "<"+successor+`>; rel="successor-version"`, rec.Header().Get("Link"), path)
+	}
+
+	// Routes that are not aliases are not deprecated
+	rec := httptest.NewRecorder()
+	server.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/healthz", nil))
+	assert.Empty(t, rec.Header().Get("Deprecation"))
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/stretchr/testify/mock"
 	"github.com/theCompanyDream/id-trials/apps/backend/controller"
 	"github.com/theCompanyDream/id-trials/apps/backend/models"
+	"github.com/theCompanyDream/id-trials/apps/backend/repository"
 	"github.com/theCompanyDream/id-trials/apps/backend/test/setup"
 	"gorm.io/gorm"
 )
@@ -70,13 +71,13 @@ func TestGetCuid_NotFound(t *testing.T) {
 	e := echo.New()
 	mockRepo := new(setup.MockRepository[models.UserCUID])
 
-	mockRepo.On("GetUser", "invalid-id").Return(nil, gorm.ErrRecordNotFound)
+	mockRepo.On("GetUser", "cmk7nncf000054hz3gxgka8v0").Return(nil, gorm.ErrRecordNotFound)
 
-	req := httptest.NewRequest(http.MethodGet, "/cuid/invalid-id", nil)
+	req := httptest.NewRequest(http.MethodGet, "/cuid/cmk7nncf000054hz3gxgka8v0", nil)
 	rec := httptest.NewRecorder()
 	c := e.NewContext(req, rec)
 	c.SetParamNames("id")
-	c.SetParamValues("invalid-id")
+	c.SetParamValues("cmk7nncf000054hz3gxgka8v0")
 
 	controller := &controller.CuidUsersController{
 		Repo: mockRepo, // Set the repo field directly
@@ -107,8 +108,7 @@ func TestGetCuid_MissingID(t *testing.T) {
 	err := controller.GetUser(c)
 
 	// Assert
-	assert.NoError(t, err) // Returns JSON error, not Go error
-	assert.Equal(t, http.StatusNotFound, rec.Code)
+	assert.ErrorIs(t, err, repository.ErrInvalidID)
 }
 
 // TestGetUsers tests the GetUsers endpoint
@@ -334,8 +334,7 @@ func TestCreateCuid_ValidationError(t *testing.T) {
 	err := controller.CreateUser(c)
 
 	// Assert
-	assert.NoError(t, err) // Returns JSON with validation errors
-	assert.Equal(t, http.StatusUnprocessableEntity, rec.Code)
+	assert.ErrorIs(t, err, repository.ErrValidation)
 }
 
 func TestCreateCuid_InvalidJSON(t *testing.T) {
@@ -466,8 +465,7 @@ func TestDeleteCuid_MissingID(t *testing.T) {
 	err := controller.DeleteUser(c)
 
 	// Assert
-	assert.Error(t, err)
-	assert.Equal(t, "id must not be null", err.Error())
+	assert.ErrorIs(t, err, repository.ErrInvalidID)
 }
 
 func TestDeleteCuid_RepositoryError(t *testing.T) {
@@ -0,0 +1,277 @@
+package controller_test
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/theCompanyDream/id-trials/apps/backend/config"
+	"github.com/theCompanyDream/id-trials/apps/backend/controller"
+	"github.com/theCompanyDream/id-trials/apps/backend/middleware"
+	"github.com/theCompanyDream/id-trials/apps/backend/models"
+	pb "github.com/theCompanyDream/id-trials/apps/backend/proto/idtrials/v1"
+	"github.com/theCompanyDream/id-trials/apps/backend/test/setup"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"gorm.io/gorm"
+)
+
+// newGRPCConn serves the gRPC services of a new server in memory and returns
+// a connection to them and the server's database.
+func newGRPCConn(t *testing.T) (*grpc.ClientConn, *gorm.DB) {
+	db := setup.NewPostgresMockDB()
+	setup.MarkMigrated(t, db)
+	// Every connection to :memory: is a separate database, and metrics are
+	// written next to the call
+	sqlDB, err := db.DB()
+	require.NoError(t, err)
+	sqlDB.SetMaxOpenConns(1)
+
+	cfg := config.Default()
+	cfg.Metrics.PoolSampleInterval = 0
+	server := controller.NewEchoServer(db, cfg)
+
+	listener := bufconn.Listen(1 << 20)
+	go server.GRPC.Serve(listener)
+	t.Cleanup(server.GRPC.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufconn",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return listener.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+	return conn, db
+}
+
+func grpcFields(userName string) *pb.UserFields {
+	department := "Quality"
+	return &pb.UserFields{
+		UserName:   userName,
+		FirstName:  "Remote",
+		LastName:   "Caller",
+		Email:      userName + "@example.com",
+		Department: &department,
+	}
+}
+
+// fieldViolations returns the BadRequest fields of a status error.
+func fieldViolations(err error) []string {
+	var fields []string
+	for _, detail := range status.Convert(err).Details() {
+		if badRequest, ok := detail.(*errdetails.BadRequest); ok {
+			for _, violation := range badRequest.GetFieldViolations() {
+				fields = append(fields, violation.GetField())
+			}
+		}
+	}
+	return fields
+}
+
+func TestGRPC_BytesUsersCRUD(t *testing.T) {
+	conn, _ := newGRPCConn(t)
+	client := pb.NewUlidUsersClient(conn)
+	ctx := context.Background()
+
+	created, err := client.CreateUser(ctx, grpcFields("grpcbytes"))
+	require.NoError(t, err)
+	require.Len(t, created.GetId(), 16, "a ULID is sent as its 16 bytes")
+	assert.Equal(t, "grpcbytes", created.GetFields().GetUserName())
+	assert.Equal(t, "Quality", created.GetFields().GetDepartment())
+
+	got, err := client.GetUser(ctx, &pb.BytesUserID{Id: created.GetId()})
+	require.NoError(t, err)
+	assert.Equal(t, created.GetId(), got.GetId())
+	assert.Equal(t, created.GetFields().GetEmail(), got.GetFields().GetEmail())
+
+	list, err := client.ListUsers(ctx, &pb.ListUsersRequest{})
+	require.NoError(t, err)
+	require.Len(t, list.GetUsers(), 1)
+	assert.Equal(t, created.GetId(), list.GetUsers()[0].GetId())
+	assert.Equal(t, int32(1), list.GetPage().GetPage())
+	assert.Equal(t, int32(25), list.GetPage().GetPageSize())
+
+	// Replacing without a department clears it
+	fields := grpcFields("grpcbytes")
+	fields.Department = nil
+	updated, err := client.UpdateUser(ctx, &pb.BytesUser{Id: created.GetId(), Fields: fields})
+	require.NoError(t, err)
+	assert.Nil(t, updated.GetFields().Department)
+	assert.Greater(t, updated.GetFields().GetVersion(), created.GetFields().GetVersion())
+
+	_, err = client.DeleteUser(ctx, &pb.BytesUserID{Id: created.GetId()})
+	require.NoError(t, err)
+	_, err = client.GetUser(ctx, &pb.BytesUserID{Id: created.GetId()})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestGRPC_EveryIDTypeRoundTrips(t *testing.T) {
+	conn, _ := newGRPCConn(t)
+	ctx := context.Background()
+
+	ulids, uuids, ksuids := pb.NewUlidUsersClient(conn), pb.NewUuidUsersClient(conn), pb.NewKsuidUsersClient(conn)
+	cuids, nanoids := pb.NewCuidUsersClient(conn), pb.NewNanoidUsersClient(conn)
+	snowflakes := pb.NewSnowflakeUsersClient(conn)
+
+	for name, roundTrip := range map[string]func(fields *pb.UserFields) (*pb.UserFields, error){
+		"ulid": func(fields *pb.UserFields) (*pb.UserFields, error) {
+			created, err := ulids.CreateUser(ctx, fields)
+			if err != nil {
+				return nil, err
+			}
+			got, err := ulids.GetUser(ctx, &pb.BytesUserID{Id: created.GetId()})
+			return got.GetFields(), err
+		},
+		"uuid": func(fields *pb.UserFields) (*pb.UserFields, error) {
+			created, err := uuids.CreateUser(ctx, fields)
+			if err != nil {
+				return nil, err
+			}
+			got, err := uuids.GetUser(ctx, &pb.BytesUserID{Id: created.GetId()})
+			return got.GetFields(), err
+		},
+		"ksuid": func(fields *pb.UserFields) (*pb.UserFields, error) {
+			created, err := ksuids.CreateUser(ctx, fields)
+			if err != nil {
+				return nil, err
+			}
+			got, err := ksuids.GetUser(ctx, &pb.BytesUserID{Id: created.GetId()})
+			return got.GetFields(), err
+		},
+		"cuid": func(fields *pb.UserFields) (*pb.UserFields, error) {
+			created, err := cuids.CreateUser(ctx, fields)
+			if err != nil {
+				return nil, err
+			}
+			got, err := cuids.GetUser(ctx, &pb.StringUserID{Id: created.GetId()})
+			return got.GetFields(), err
+		},
+		"nanoid": func(fields *pb.UserFields) (*pb.UserFields, error) {
+			created, err := nanoids.CreateUser(ctx, fields)
+			if err != nil {
+				return nil, err
+			}
+			got, err := nanoids.GetUser(ctx, &pb.StringUserID{Id: created.GetId()})
+			return got.GetFields(), err
+		},
+		"snowflake": func(fields *pb.UserFields) (*pb.UserFields, error) {
+			created, err := snowflakes.CreateUser(ctx, fields)
+			if err != nil {
+				return nil, err
+			}
+			got, err := snowflakes.GetUser(ctx, &pb.Int64UserID{Id: created.GetId()})
+			return got.GetFields(), err
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			fields, err := roundTrip(grpcFields("grpc" + name))
+			require.NoError(t, err)
+			assert.Equal(t, "grpc"+name, fields.GetUserName())
+			assert.Equal(t, int64(1), fields.GetVersion())
+		})
+	}
+}
+
+func TestGRPC_Errors(t *testing.T) {
+	conn, _ := newGRPCConn(t)
+	ctx := context.Background()
+	ulids := pb.NewUlidUsersClient(conn)
+
+	for name, call := range map[string]func() error{
+		"short id": func() error {
+			_, err := ulids.GetUser(ctx, &pb.BytesUserID{Id: []byte("short")})
+			return err
+		},
+		"missing id": func() error {
+			_, err := ulids.DeleteUser(ctx, &pb.BytesUserID{})
+			return err
+		},
+		"not a uuid4": func() error {
+			id := ulid.Make()
+			_, err := pb.NewUuidUsersClient(conn).GetUser(ctx, &pb.BytesUserID{Id: id[:]})
+			return err
+		},
+		"negative snowflake": func() error {
+			_, err := pb.NewSnowflakeUsersClient(conn).GetUser(ctx, &pb.Int64UserID{Id: -1})
+			return err
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			err := call()
+			assert.Equal(t, codes.InvalidArgument, status.Code(err))
+			assert.Equal(t, []string{"id"}, fieldViolations(err))
+		})
+	}
+
+	// Every field but the department is required
+	_, err := ulids.CreateUser(ctx, &pb.UserFields{UserName: "grpcmissing"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	assert.ElementsMatch(t, []string{"Email", "FirstName", "LastName"}, fieldViolations(err))
+
+	id := ulid.Make()
+	_, err = ulids.UpdateUser(ctx, &pb.BytesUser{Id: id[:], Fields: grpcFields("grpcnobody")})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestGRPC_ConditionalUpdate(t *testing.T) {
+	conn, _ := newGRPCConn(t)
+	ctx := context.Background()
+	client := pb.NewCuidUsersClient(conn)
+
+	created, err := client.CreateUser(ctx, grpcFields("grpcversion"))
+	require.NoError(t, err)
+
+	// The version the client has seen makes the write conditional
+	fields := grpcFields("grpcversion")
+	fields.Version = created.GetFields().GetVersion()
+	updated, err := client.UpdateUser(ctx, &pb.StringUser{Id: created.GetId(), Fields: fields})
+	require.NoError(t, err)
+	assert.Equal(t, fields.Version+1, updated.GetFields().GetVersion())
+
+	// Writing the same version again loses to the write above
+	_, err = client.UpdateUser(ctx, &pb.StringUser{Id: created.GetId(), Fields: fields})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
+
+func TestGRPC_RecordsMetrics(t *testing.T) {
+	conn, db := newGRPCConn(t)
+	ctx := metadata.AppendToOutgoingContext(context.Background(), middleware.MetadataRequestID, "grpc-request")
+	client := pb.NewKsuidUsersClient(conn)
+
+	var header metadata.MD
+	created, err := client.CreateUser(ctx, grpcFields("grpcmetrics"), grpc.Header(&header))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"grpc-request"}, header.Get(middleware.MetadataRequestID))
+
+	_, err = client.GetUser(context.Background(), &pb.BytesUserID{Id: make([]byte, 20)}, grpc.Header(&header))
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	assert.Len(t, header.Get(middleware.MetadataRequestID)[0], 32, "a request ID is generated when none is sent")
+
+	var metrics []models.RouteMetric
+	require.Eventually(t, func() bool {
+		metrics = nil
+		return db.Order("id").Find(&metrics).Error == nil && len(metrics) == 2
+	}, time.Second, 10*time.Millisecond)
+
+	assert.Equal(t, pb.KsuidUsers_CreateUser_FullMethodName, metrics[0].RoutePath)
+	assert.Equal(t, models.ProtocolGRPC, metrics[0].Protocol)
+	assert.Equal(t, "KSUID", metrics[0].IDType)
+	assert.Equal(t, http.StatusOK, metrics[0].StatusCode)
+	assert.Equal(t, "grpc-request", metrics[0].RequestID)
+	assert.Positive(t, metrics[0].ResponseSize)
+	assert.NotEmpty(t, created.GetId())
+
+	// Failures are recorded with the status they would have had over HTTP
+	assert.Equal(t, pb.KsuidUsers_GetUser_FullMethodName, metrics[1].RoutePath)
+	assert.Equal(t, http.StatusNotFound, metrics[1].StatusCode)
+	assert.True(t, metrics[1].IsError)
+}
@@ -0,0 +1,106 @@
+package controller_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/theCompanyDream/id-trials/apps/backend/config"
+	"github.com/theCompanyDream/id-trials/apps/backend/controller"
+	"github.com/theCompanyDream/id-trials/apps/backend/models"
+	"github.com/theCompanyDream/id-trials/apps/backend/test/setup"
+	"gorm.io/gorm"
+)
+
+func healthServer(t *testing.T, db *gorm.DB, configure func(*config.Config)) *controller.Server {
+	cfg := config.Default()
+	cfg.Metrics.PoolSampleInterval = 0
+	cfg.Limits.RateLimit = 0
+	if configure != nil {
+		configure(cfg)
+	}
+	return controller.NewEchoServer(db, cfg)
+}
+
+func get(server http.Handler, path string, body interface{}) int {
+	rec := httptest.NewRecorder()
+	server.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, path, nil))
+	if body != nil {
+		json.Unmarshal(rec.Body.Bytes(), body)
+	}
+	return rec.Code
+}
+
+func TestHealthz(t *testing.T) {
+	var status models.HealthStatus
+	assert.Equal(t, http.StatusOK, get(healthServer(t, setup.NewPostgresMockDB(), nil), "/healthz", &status))
+	assert.Equal(t, "ok", status.Status)
+}
+
+func TestReadyz_ChecksMigrationsAndMetrics(t *testing.T) {
+	db := setup.NewPostgresMockDB()
+	server := healthServer(t, db, nil)
+
+	var status models.HealthStatus
+	assert.Equal(t, http.StatusServiceUnavailable, get(server, "/readyz", &status))
+	assert.Equal(t, "unavailable", status.Status)
+	assert.Equal(t, "ok", status.Checks["database"])
+	assert.Contains(t, status.Checks["migrations"], "pending")
+
+	setup.MarkMigrated(t, db)
+	status = models.HealthStatus{}
+	assert.Equal(t, http.StatusOK, get(server, "/readyz", &status))
+	assert.Equal(t, map[string]string{"database": "ok", "migrations": "ok", "metrics": "ok"}, status.Checks)
+
+	// A failing metric write makes the server unready until one succeeds
+	require.NoError(t, db.Migrator().DropTable(&models.RouteMetric{}))
+	get(server, "/ulidIds", nil)
+	require.Eventually(t, func() bool {
+		status = models.HealthStatus{}
+		return get(server, "/readyz", &status) == http.StatusServiceUnavailable
+	}, time.Second, 10*time.Millisecond)
+	assert.NotEqual(t, "ok", status.Checks["metrics"])
+}
+
+func TestDebugInfo(t *testing.T) {
+	db := setup.NewPostgresMockDB()
+	sqlDB, err := db.DB()
+	require.NoError(t, err)
+	sqlDB.SetMaxOpenConns(3)
+
+	server := healthServer(t, db, func(cfg *config.Config) {
+		cfg.Database.Password = "hunter2"
+		cfg.Database.URL = "postgres://bench:hunter2@db/ids"
+	})
+
+	var info models.DebugInfo
+	require.Equal(t, http.StatusOK, get(server, "/debug/info", &info))
+	assert.Equal(t, controller.Version, info.Version)
+	assert.GreaterOrEqual(t, info.UptimeSeconds, 0.0)
+	assert.Equal(t, 3, info.Pool.MaxOpen)
+
+	idTypes := make([]string, 0, len(info.IDTypes))
+	for _, idType := range info.IDTypes {
+		idTypes = append(idTypes, idType.IDType)
+		assert.Positive(t, idType.Routes)
+	}
+	assert.Equal(t, []string{"CUID", "KSUID", "NanoID", "Snowflake", "ULID", "UUID"}, idTypes)
+
+	database := info.Config["database"].(map[string]interface{})
+	assert.Equal(t, "xxxxx", database["password"])
+	assert.Equal(t, "postgres://bench:xxxxx@db/ids", database["url"])
+	assert.Equal(t, "30m0s", database["conn_max_lifetime"])
+}
+
+func TestPprofIsOptIn(t *testing.T) {
+	db := setup.NewPostgresMockDB()
+	assert.Equal(t, http.StatusNotFound, get(healthServer(t, db, nil), "/debug/pprof/", nil))
+
+	server := healthServer(t, db, func(cfg *config.Config) { cfg.Debug.Pprof = true })
+	assert.Equal(t, http.StatusOK, get(server, "/debug/pprof/", nil))
+	assert.Equal(t, http.StatusOK, get(server, "/debug/pprof/cmdline", nil))
+}
@@ -0,0 +1,123 @@
+package controller_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/theCompanyDream/id-trials/apps/backend/controller"
+	"github.com/theCompanyDream/id-trials/apps/backend/models"
+	"github.com/theCompanyDream/id-trials/apps/backend/repository"
+	"github.com/theCompanyDream/id-trials/apps/backend/test/setup"
+)
+
+// TestGetUser_InvalidPathID checks every ID type rejects a path ID of another
+// format with an invalid ID error before the repository is queried.
+func TestGetUser_InvalidPathID(t *testing.T) {
+	ulidRepo := new(setup.MockRepository[models.UserUlid])
+	uuidRepo := new(setup.MockRepository[models.UserUUID])
+	ksuidRepo := new(setup.MockRepository[models.UserKSUID])
+	cuidRepo := new(setup.MockRepository[models.UserCUID])
+	nanoRepo := new(setup.MockRepository[models.UserNanoID])
+	snowRepo := new(setup.MockRepository[models.UserSnowflake])
+
+	tests := []struct {
+		name       string
+		id         string
+		tag        string
+		controller controller.IUserController
+		repo       interface {
+			AssertNotCalled(mock.TestingT, string, ...interface{}) bool
+		}
+	}{
+		{"ULID", "f47ac10b-58cc-4372-a567-0e02b2c3d479", "ulid", &controller.UsersUlidControllers{Repo: ulidRepo}, ulidRepo},
+		{"UUID", "01HZX3K4Q2M8V6T9R5N7B1C0DE", "uuid4", &controller.UuidUsersController{Repo: uuidRepo}, uuidRepo},
+		{"KSUID", "01HZX3K4Q2M8V6T9R5N7B1C0DE", "ksuid", &controller.KsuidUsersController{Repo: ksuidRepo}, ksuidRepo},
+		{"CUID", "V1StGXR8_Z5jdHi6B-myT", "cuid2", &controller.CuidUsersController{Repo: cuidRepo}, cuidRepo},
+		{"NanoID", "cmk7nncf000054hz3gxgka8v9", "nanoid", &controller.NanoUsersController{Repo: nanoRepo}, nanoRepo},
+		{"Snowflake", "-42", "snowflake", &controller.SnowUsersController{Repo: snowRepo}, snowRepo},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetParamNames("id")
+			c.SetParamValues(tt.id)
+
+			err := tt.controller.GetUser(c)
+
+			var domainErr *repository.DomainError
+			assert.ErrorAs(t, err, &domainErr)
+			assert.Equal(t, repository.KindInvalidID, domainErr.Kind)
+			assert.Contains(t, domainErr.Fields["id"], "'"+tt.tag+"'")
+			tt.repo.AssertNotCalled(t, "GetUser", mock.Anything)
+		})
+	}
+}
+
+func TestUpdateUlid_InvalidBodyID(t *testing.T) {
+	// Arrange
+	e := echo.New()
+	mockRepo := new(setup.MockRepository[models.UserUlid])
+
+	bodyID := "f47ac10b-58cc-4372-a567-0e02b2c3d479"
+	body, _ := json.Marshal(models.UserInput{Id: &bodyID})
+	req := httptest.NewRequest(http.MethodPut, "/ulidId/01HZX3K4Q2M8V6T9R5N7B1C0DE", strings.NewReader(string(body)))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetParamNames("id")
+	c.SetParamValues("01HZX3K4Q2M8V6T9R5N7B1C0DE")
+
+	controller := &controller.UsersUlidControllers{Repo: mockRepo}
+
+	// Act
+	err := controller.UpdateUser(c)
+
+	// Assert
+	assert.ErrorIs(t, err, repository.ErrValidation)
+	mockRepo.AssertNotCalled(t, "UpdateUser", mock.Anything)
+}
+
+func TestUpdateUlid_ValidBodyID(t *testing.T) {
+	// Arrange
+	e := echo.New()
+	mockRepo := new(setup.MockRepository[models.UserUlid])
+
+	userID := "01HZX3K4Q2M8V6T9R5N7B1C0DE"
+	mockRepo.On("UpdateUser", mock.AnythingOfType("models.UserUlid")).
+		Return(&models.UserUlid{ID: userID, UserBase: &models.UserBase{}}, nil)
+
+	userName, firstName, lastName, email := "testuser", "Test", "User", "test@example.com"
+	body, _ := json.Marshal(models.UserInput{
+		Id:        &userID,
+		UserName:  &userName,
+		FirstName: &firstName,
+		LastName:  &lastName,
+		Email:     &email,
+	})
+	req := httptest.NewRequest(http.MethodPut, "/ulidId/"+userID, strings.NewReader(string(body)))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetParamNames("id")
+	c.SetParamValues(userID)
+
+	controller := &controller.UsersUlidControllers{Repo: mockRepo}
+
+	// Act
+	err := controller.UpdateUser(c)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	mockRepo.AssertExpectations(t)
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/stretchr/testify/mock"
 	"github.com/theCompanyDream/id-trials/apps/backend/controller"
 	"github.com/theCompanyDream/id-trials/apps/backend/models"
+	"github.com/theCompanyDream/id-trials/apps/backend/repository"
 	"github.com/theCompanyDream/id-trials/apps/backend/test/setup"
 	"gorm.io/gorm"
 )
@@ -24,7 +25,7 @@ func TestGetKsuid_Success(t *testing.T) {
 	department := "Engineering"
 
 	expectedUser := &models.UserKSUID{
-		ID: "cmk7nncf000054hz3gxgka8v9",
+		ID: "2ZQ1gR7H4fXk9sLmN3pT6vYw8bC",
 		UserBase: &models.UserBase{
 			UserName:   "testuser",
 			FirstName:  "Test",
@@ -34,13 +35,13 @@ func TestGetKsuid_Success(t *testing.T) {
 		},
 	}
 
-	mockRepo.On("GetUser", "cmk7nncf000054hz3gxgka8v9").Return(expectedUser, nil)
+	mockRepo.On("GetUser", "2ZQ1gR7H4fXk9sLmN3pT6vYw8bC").Return(expectedUser, nil)
 
-	req := httptest.NewRequest(http.MethodGet, "/ksuid/cmk7nncf000054hz3gxgka8v9", nil)
+	req := httptest.NewRequest(http.MethodGet, "/ksuid/2ZQ1gR7H4fXk9sLmN3pT6vYw8bC", nil)
 	rec := httptest.NewRecorder()
 	c := e.NewContext(req, rec)
 	c.SetParamNames("id")
-	c.SetParamValues("cmk7nncf000054hz3gxgka8v9")
+	c.SetParamValues("2ZQ1gR7H4fXk9sLmN3pT6vYw8bC")
 
 	// Note: You'll need to expose the repo field or use dependency injection
 	// For now, this shows the pattern
@@ -70,13 +71,13 @@ func TestGetKsuid_NotFound(t *testing.T) {
 	e := echo.New()
 	mockRepo := new(setup.MockRepository[models.UserKSUID])
 
-	mockRepo.On("GetUser", "invalid-id").Return(nil, gorm.ErrRecordNotFound)
+	mockRepo.On("GetUser", "2ZQ1gR7H4fXk9sLmN3pT6vYw8bD").Return(nil, gorm.ErrRecordNotFound)
 
-	req := httptest.NewRequest(http.MethodGet, "/ksuid/invalid-id", nil)
+	req := httptest.NewRequest(http.MethodGet, "/ksuid/2ZQ1gR7H4fXk9sLmN3pT6vYw8bD", nil)
 	rec := httptest.NewRecorder()
 	c := e.NewContext(req, rec)
 	c.SetParamNames("id")
-	c.SetParamValues("invalid-id")
+	c.SetParamValues("2ZQ1gR7H4fXk9sLmN3pT6vYw8bD")
 
 	controller := &controller.KsuidUsersController{
 		Repo: mockRepo, // Set the repo field directly
@@ -107,8 +108,7 @@ func TestGetKsuid_MissingID(t *testing.T) {
 	err := controller.GetUser(c)
 
 	// Assert
-	assert.NoError(t, err) // Returns JSON error, not Go error
-	assert.Equal(t, http.StatusNotFound, rec.Code)
+	assert.ErrorIs(t, err, repository.ErrInvalidID)
 }
 
 // TestGetUsers tests the GetUsers endpoint
@@ -271,7 +271,7 @@ func TestCreateKsuid_Success(t *testing.T) {
 	}
 
 	createdUser := &models.UserKSUID{
-		ID: "cmk7nncf000054hz3gxgka8v9",
+		ID: "2ZQ1gR7H4fXk9sLmN3pT6vYw8bC",
 		UserBase: &models.UserBase{
 			UserName:   userName,
 			FirstName:  firstName,
@@ -334,8 +334,7 @@ func TestCreateKsuid_ValidationError(t *testing.T) {
 	err := controller.CreateUser(c)
 
 	// Assert
-	assert.NoError(t, err) // Returns JSON with validation errors
-	assert.Equal(t, http.StatusUnprocessableEntity, rec.Code)
+	assert.ErrorIs(t, err, repository.ErrValidation)
 }
 
 func TestCreateKsuid_InvalidJSON(t *testing.T) {
@@ -365,7 +364,7 @@ func TestUpdateKsuid_Success(t *testing.T) {
 	e := echo.New()
 	mockRepo := new(setup.MockRepository[models.UserKSUID])
 
-	userID := "cmk7nncf000054hz3gxgka8v9"
+	userID := "2ZQ1gR7H4fXk9sLmN3pT6vYw8bC"
 	userName := "updateduser"
 	firstName := "Updated"
 	lastName := "User"
@@ -426,7 +425,7 @@ func TestDeleteKsuid_Success(t *testing.T) {
 	e := echo.New()
 	mockRepo := new(setup.MockRepository[models.UserKSUID])
 
-	userID := "cmk7nncf000054hz3gxgka8v9"
+	userID := "2ZQ1gR7H4fXk9sLmN3pT6vYw8bC"
 
 	mockRepo.On("DeleteUser", userID).Return(nil)
 
@@ -466,8 +465,7 @@ func TestDeleteKsuid_MissingID(t *testing.T) {
 	err := controller.DeleteUser(c)
 
 	// Assert
-	assert.Error(t, err)
-	assert.Equal(t, "id must not be null", err.Error())
+	assert.ErrorIs(t, err, repository.ErrInvalidID)
 }
 
 func TestDeleteKsuid_RepositoryError(t *testing.T) {
@@ -475,7 +473,7 @@ func TestDeleteKsuid_RepositoryError(t *testing.T) {
 	e := echo.New()
 	mockRepo := new(setup.MockRepository[models.UserKSUID])
 
-	userID := "cmk7nncf000054hz3gxgka8v9"
+	userID := "2ZQ1gR7H4fXk9sLmN3pT6vYw8bC"
 
 	mockRepo.On("DeleteUser", userID).Return(gorm.ErrRecordNotFound)
 
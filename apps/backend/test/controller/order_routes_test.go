@@ -0,0 +1,194 @@
+package controller_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/theCompanyDream/id-trials/apps/backend/config"
+	"github.com/theCompanyDream/id-trials/apps/backend/controller"
+	"github.com/theCompanyDream/id-trials/apps/backend/models"
+	"github.com/theCompanyDream/id-trials/apps/backend/repository"
+	"github.com/theCompanyDream/id-trials/apps/backend/test/setup"
+)
+
+func TestGetUlidOrders_Success(t *testing.T) {
+	// Arrange
+	e := echo.New()
+	mockRepo := new(setup.MockOrderRepository[models.OrderUlid])
+	page := 1
+	limit := 25
+	total := 1
+
+	expectedOrders := &models.OrderPaging{
+		Orders: []models.OrderDTO{
+			{ID: "order1", UserID: "01HZX3K4Q2M8V6T9R5N7B1C0DE", UserName: "testuser", Product: "Widget", Quantity: 2, TotalCents: 500, Status: "paid"},
+		},
+		Paging: models.Paging{Page: &page, PageCount: &total, PageSize: &limit},
+	}
+
+	mockRepo.On("GetOrdersByUser", "01HZX3K4Q2M8V6T9R5N7B1C0DE", 1, 25).Return(expectedOrders, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/ulidId/01HZX3K4Q2M8V6T9R5N7B1C0DE/orders", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetParamNames("id")
+	c.SetParamValues("01HZX3K4Q2M8V6T9R5N7B1C0DE")
+
+	controller := &controller.UlidOrdersController{Repo: mockRepo}
+
+	// Act
+	err := controller.GetOrders(c)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	var response models.OrderPaging
+	json.Unmarshal(rec.Body.Bytes(), &response)
+	assert.Equal(t, 1, len(response.Orders))
+	assert.Equal(t, "testuser", response.Orders[0].UserName)
+
+	mockRepo.AssertExpectations(t)
+}
+
+func TestCreateUlidOrder_Success(t *testing.T) {
+	// Arrange
+	e := echo.New()
+	mockRepo := new(setup.MockOrderRepository[models.OrderUlid])
+
+	product := "Widget"
+	quantity := 2
+	orderInput := models.OrderInput{Product: &product, Quantity: &quantity}
+
+	createdOrder := &models.OrderUlid{
+		ID:        "order1",
+		UserID:    "01HZX3K4Q2M8V6T9R5N7B1C0DE",
+		OrderBase: &models.OrderBase{Product: product, Quantity: quantity, Status: "pending"},
+	}
+
+	mockRepo.On("CreateOrder", mock.MatchedBy(func(order models.OrderUlid) bool {
+		return order.UserID == "01HZX3K4Q2M8V6T9R5N7B1C0DE" && order.Product == product
+	})).Return(createdOrder, nil)
+
+	body, _ := json.Marshal(orderInput)
+	req := httptest.NewRequest(http.MethodPost, "/ulidId/01HZX3K4Q2M8V6T9R5N7B1C0DE/orders", strings.NewReader(string(body)))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetParamNames("id")
+	c.SetParamValues("01HZX3K4Q2M8V6T9R5N7B1C0DE")
+
+	controller := &controller.UlidOrdersController{Repo: mockRepo}
+
+	// Act
+	err := controller.CreateOrder(c)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusCreated, rec.Code)
+
+	var response models.OrderUlid
+	json.Unmarshal(rec.Body.Bytes(), &response)
+	assert.Equal(t, "order1", response.ID)
+	assert.Equal(t, "01HZX3K4Q2M8V6T9R5N7B1C0DE", response.UserID)
+
+	mockRepo.AssertExpectations(t)
+}
+
+func TestCreateUlidOrder_ValidationError(t *testing.T) {
+	// Arrange
+	e := echo.New()
+	mockRepo := new(setup.MockOrderRepository[models.OrderUlid])
+
+	status := "lost"
+	body, _ := json.Marshal(models.OrderInput{Status: &status})
+	req := httptest.NewRequest(http.MethodPost, "/ulidId/01HZX3K4Q2M8V6T9R5N7B1C0DE/orders", strings.NewReader(string(body)))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetParamNames("id")
+	c.SetParamValues("01HZX3K4Q2M8V6T9R5N7B1C0DE")
+
+	controller := &controller.UlidOrdersController{Repo: mockRepo}
+
+	// Act
+	err := controller.CreateOrder(c)
+
+	// Assert
+	assert.ErrorIs(t, err, repository.ErrValidation)
+	mockRepo.AssertNotCalled(t, "CreateOrder", mock.Anything)
+}
+
+// TestCreateOrder_ProductRequired checks that every ID type refuses to create
+// an order without a product, which the NOT NULL column would store as ”.
+func TestCreateOrder_ProductRequired(t *testing.T) {
+	cfg := config.Default()
+	cfg.Metrics.PoolSampleInterval = 0
+	server := controller.NewEchoServer(setup.NewPostgresMockDB(), cfg)
+
+	empty := ""
+	for path, input := range map[string]models.OrderInput{
+		"/api/v1/ids/ulid/users/01HZX3K4Q2M8V6T9R5N7B1C0DE/orders": {},
+		"/api/v1/ids/snowflake/users/1541815603606036480/orders":   {Product: &empty},
+		"/api/v1/ids/cuid/users/tz4a98xxat96iws9zmbrgj3a/orders":   {},
+	} {
+		body, _ := json.Marshal(input)
+		req := httptest.NewRequest(http.MethodPost, path, strings.NewReader(string(body)))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		server.ServeHTTP(rec, req)
+		assert.Equal(t, http.StatusUnprocessableEntity, rec.Code, path)
+		assert.Contains(t, rec.Body.String(), "Product", path)
+	}
+}
+
+func TestDeleteSnowOrder_InvalidID(t *testing.T) {
+	// Arrange
+	e := echo.New()
+	mockRepo := new(setup.MockOrderRepository[models.OrderSnowflake])
+
+	req := httptest.NewRequest(http.MethodDelete, "/snowOrder/abc", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetParamNames("id")
+	c.SetParamValues("abc")
+
+	controller := &controller.SnowOrdersController{Repo: mockRepo}
+
+	// Act
+	err := controller.DeleteOrder(c)
+
+	// Assert
+	assert.ErrorIs(t, err, repository.ErrInvalidID)
+	mockRepo.AssertNotCalled(t, "DeleteOrder", mock.Anything)
+}
+
+// TestOrders_RefusedWithPartitionedStorage checks that the ID types served from
+// partitioned tables refuse their order routes, whose foreign keys point at
+// the unpartitioned users tables, and that the others keep serving them.
+func TestOrders_RefusedWithPartitionedStorage(t *testing.T) {
+	db := setup.NewPostgresMockDB()
+	cfg := config.Default()
+	cfg.Metrics.PoolSampleInterval = 0
+	cfg.Storage.Partitioned = true
+	repository.Configure(cfg)
+	t.Cleanup(func() { repository.Configure(config.Default()) })
+	server := controller.NewEchoServer(db, cfg)
+
+	for path, status := range map[string]int{
+		"/api/v1/ids/ulid/users/01HZX3K4Q2M8V6T9R5N7B1C0DE/orders":      http.StatusConflict,
+		"/api/v1/ids/snowflake/orders/1541815603606036480":              http.StatusConflict,
+		"/api/v1/ids/cuid/users/tz4a98xxat96iws9zmbrgj3a/orders":        http.StatusNotFound,
+		"/api/v1/ids/nanoid/users/V1StGXR8_Z5jdHi6B-myTV1StGXR8/orders": http.StatusBadRequest,
+	} {
+		rec := httptest.NewRecorder()
+		server.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, path, nil))
+		assert.Equal(t, status, rec.Code, path)
+	}
+}
@@ -0,0 +1,120 @@
+package controller_test
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/theCompanyDream/id-trials/apps/backend/config"
+	"github.com/theCompanyDream/id-trials/apps/backend/controller"
+	"github.com/theCompanyDream/id-trials/apps/backend/models"
+	pb "github.com/theCompanyDream/id-trials/apps/backend/proto/idtrials/v1"
+	"github.com/theCompanyDream/id-trials/apps/backend/test/setup"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+func TestServerRun_DrainsOnShutdown(t *testing.T) {
+	db := setup.NewPostgresMockDB()
+	sqlDB, err := db.DB()
+	require.NoError(t, err)
+	sqlDB.SetMaxOpenConns(1)
+	setup.MarkMigrated(t, db)
+
+	cfg := config.Default()
+	cfg.Metrics.PoolSampleInterval = 0
+	cfg.Server.DrainDelay = 200 * time.Millisecond
+
+	server := controller.NewEchoServer(db, cfg)
+	server.HideBanner = true
+	server.HidePort = true
+	server.GET("/ulidIds/slow", func(c echo.Context) error {
+		time.Sleep(400 * time.Millisecond)
+		return c.String(http.StatusOK, "done")
+	})
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	server.Listener = listener
+	base := "http://" + listener.Addr().String()
+	server.GRPCListener, err = net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	conn, err := grpc.NewClient(server.GRPCListener.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	defer conn.Close()
+	grpcHealth := func() healthpb.HealthCheckResponse_ServingStatus {
+		res, err := healthpb.NewHealthClient(conn).Check(context.Background(), &healthpb.HealthCheckRequest{})
+		if err != nil {
+			return healthpb.HealthCheckResponse_UNKNOWN
+		}
+		return res.GetStatus()
+	}
+
+	ctx, stop := context.WithCancel(context.Background())
+	defer stop()
+	stopped := make(chan error, 1)
+	go func() { stopped <- server.Run(ctx) }()
+
+	readyz := func() int {
+		res, err := http.Get(base + "/readyz")
+		if err != nil {
+			return 0
+		}
+		res.Body.Close()
+		return res.StatusCode
+	}
+	require.Eventually(t, func() bool { return readyz() == http.StatusOK }, time.Second, 10*time.Millisecond)
+	require.Equal(t, healthpb.HealthCheckResponse_SERVING, grpcHealth())
+
+	slow := make(chan int, 1)
+	go func() {
+		res, err := http.Get(base + "/ulidIds/slow")
+		if err != nil {
+			slow <- 0
+			return
+		}
+		res.Body.Close()
+		slow <- res.StatusCode
+	}()
+	time.Sleep(50 * time.Millisecond)
+	stop()
+
+	// Readiness fails while the listener still accepts requests
+	assert.Eventually(t, func() bool { return readyz() == http.StatusServiceUnavailable }, 150*time.Millisecond, 10*time.Millisecond)
+	assert.False(t, server.Ready())
+	assert.Equal(t, healthpb.HealthCheckResponse_NOT_SERVING, grpcHealth())
+
+	// Calls still arrive until the drain delay is over
+	created, err := pb.NewUlidUsersClient(conn).CreateUser(context.Background(), &pb.UserFields{
+		UserName: "draining", FirstName: "Late", LastName: "Caller", Email: "late@example.com",
+	})
+	require.NoError(t, err)
+	assert.Len(t, created.GetId(), 16)
+
+	// The in-flight request finishes and its metric is written before Run returns
+	assert.Equal(t, http.StatusOK, <-slow)
+	require.NoError(t, <-stopped)
+
+	var metric models.RouteMetric
+	require.NoError(t, db.Where("route_path = ?", "/ulidIds/slow").First(&metric).Error)
+	assert.Equal(t, http.StatusOK, metric.StatusCode)
+	assert.Equal(t, models.ProtocolHTTP, metric.Protocol)
+	var grpcMetric models.RouteMetric
+	require.NoError(t, db.Where("route_path = ?", pb.UlidUsers_CreateUser_FullMethodName).First(&grpcMetric).Error)
+	assert.Equal(t, models.ProtocolGRPC, grpcMetric.Protocol)
+}
+
+func TestServerRun_ReturnsListenErrors(t *testing.T) {
+	cfg := config.Default()
+	cfg.Metrics.PoolSampleInterval = 0
+	cfg.Server.Host = "256.0.0.1"
+
+	server := controller.NewEchoServer(setup.NewPostgresMockDB(), cfg)
+	server.HideBanner = true
+	assert.Error(t, server.Run(context.Background()))
+}
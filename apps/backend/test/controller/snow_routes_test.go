@@ -14,6 +14,7 @@ import (
 	"github.com/stretchr/testify/mock"
 	"github.com/theCompanyDream/id-trials/apps/backend/controller"
 	"github.com/theCompanyDream/id-trials/apps/backend/models"
+	"github.com/theCompanyDream/id-trials/apps/backend/repository"
 	"github.com/theCompanyDream/id-trials/apps/backend/test/setup"
 	"gorm.io/gorm"
 )
@@ -109,8 +110,7 @@ func TestGetSnowFlake_MissingID(t *testing.T) {
 	err := controller.GetUser(c)
 
 	// Assert
-	assert.NoError(t, err) // Returns JSON error, not Go error
-	assert.Equal(t, http.StatusNotFound, rec.Code)
+	assert.ErrorIs(t, err, repository.ErrInvalidID)
 }
 
 // TestGetUsers tests the GetUsers endpoint
@@ -336,8 +336,7 @@ func TestCreateSnowFlake_ValidationError(t *testing.T) {
 	err := controller.CreateUser(c)
 
 	// Assert
-	assert.NoError(t, err) // Returns JSON with validation errors
-	assert.Equal(t, http.StatusUnprocessableEntity, rec.Code)
+	assert.ErrorIs(t, err, repository.ErrValidation)
 }
 
 func TestCreateSnowFlake_InvalidJSON(t *testing.T) {
@@ -470,8 +469,7 @@ func TestDeleteSnowFlake_MissingID(t *testing.T) {
 	err := controller.DeleteUser(c)
 
 	// Assert
-	assert.Error(t, err)
-	assert.Equal(t, "id must not be null", err.Error())
+	assert.ErrorIs(t, err, repository.ErrInvalidID)
 }
 
 func TestDeleteSnowFlake_RepositoryError(t *testing.T) {
@@ -12,6 +12,7 @@ import (
 	"github.com/stretchr/testify/mock"
 	"github.com/theCompanyDream/id-trials/apps/backend/controller"
 	"github.com/theCompanyDream/id-trials/apps/backend/models"
+	"github.com/theCompanyDream/id-trials/apps/backend/repository"
 	"github.com/theCompanyDream/id-trials/apps/backend/test/setup"
 	"gorm.io/gorm"
 )
@@ -24,7 +25,7 @@ func TestGetUlid_Success(t *testing.T) {
 	department := "Engineering"
 
 	expectedUser := &models.UserUlid{
-		ID: "cmk7nncf000054hz3gxgka8v9",
+		ID: "01HZX3K4Q2M8V6T9R5N7B1C0DE",
 		UserBase: &models.UserBase{
 			UserName:   "testuser",
 			FirstName:  "Test",
@@ -34,13 +35,13 @@ func TestGetUlid_Success(t *testing.T) {
 		},
 	}
 
-	mockRepo.On("GetUser", "cmk7nncf000054hz3gxgka8v9").Return(expectedUser, nil)
+	mockRepo.On("GetUser", "01HZX3K4Q2M8V6T9R5N7B1C0DE").Return(expectedUser, nil)
 
-	req := httptest.NewRequest(http.MethodGet, "/ulid/cmk7nncf000054hz3gxgka8v9", nil)
+	req := httptest.NewRequest(http.MethodGet, "/ulid/01HZX3K4Q2M8V6T9R5N7B1C0DE", nil)
 	rec := httptest.NewRecorder()
 	c := e.NewContext(req, rec)
 	c.SetParamNames("id")
-	c.SetParamValues("cmk7nncf000054hz3gxgka8v9")
+	c.SetParamValues("01HZX3K4Q2M8V6T9R5N7B1C0DE")
 
 	// Note: You'll need to expose the repo field or use dependency injection
 	// For now, this shows the pattern
@@ -70,13 +71,13 @@ func TestGetUlid_NotFound(t *testing.T) {
 	e := echo.New()
 	mockRepo := new(setup.MockRepository[models.UserUlid])
 
-	mockRepo.On("GetUser", "invalid-id").Return(nil, gorm.ErrRecordNotFound)
+	mockRepo.On("GetUser", "01HZX3K4Q2M8V6T9R5N7B1C0DF").Return(nil, gorm.ErrRecordNotFound)
 
-	req := httptest.NewRequest(http.MethodGet, "/ulid/invalid-id", nil)
+	req := httptest.NewRequest(http.MethodGet, "/ulid/01HZX3K4Q2M8V6T9R5N7B1C0DF", nil)
 	rec := httptest.NewRecorder()
 	c := e.NewContext(req, rec)
 	c.SetParamNames("id")
-	c.SetParamValues("invalid-id")
+	c.SetParamValues("01HZX3K4Q2M8V6T9R5N7B1C0DF")
 
 	controller := &controller.UsersUlidControllers{
 		Repo: mockRepo, // Set the repo field directly
@@ -107,8 +108,7 @@ func TestGetUlid_MissingID(t *testing.T) {
 	err := controller.GetUser(c)
 
 	// Assert
-	assert.NoError(t, err) // Returns JSON error, not Go error
-	assert.Equal(t, http.StatusNotFound, rec.Code)
+	assert.ErrorIs(t, err, repository.ErrInvalidID)
 }
 
 // TestGetUsers tests the GetUsers endpoint
@@ -271,7 +271,7 @@ func TestCreateUlid_Success(t *testing.T) {
 	}
 
 	createdUser := &models.UserUlid{
-		ID: "cmk7nncf000054hz3gxgka8v9",
+		ID: "01HZX3K4Q2M8V6T9R5N7B1C0DE",
 		UserBase: &models.UserBase{
 			UserName:   userName,
 			FirstName:  firstName,
@@ -334,8 +334,7 @@ func TestCreateUlid_ValidationError(t *testing.T) {
 	err := controller.CreateUser(c)
 
 	// Assert
-	assert.NoError(t, err) // Returns JSON with validation errors
-	assert.Equal(t, http.StatusUnprocessableEntity, rec.Code)
+	assert.ErrorIs(t, err, repository.ErrValidation)
 }
 
 func TestCreateUlid_InvalidJSON(t *testing.T) {
@@ -365,7 +364,7 @@ func TestUpdateUlid_Success(t *testing.T) {
 	e := echo.New()
 	mockRepo := new(setup.MockRepository[models.UserUlid])
 
-	userID := "cmk7nncf000054hz3gxgka8v9"
+	userID := "01HZX3K4Q2M8V6T9R5N7B1C0DE"
 	userName := "updateduser"
 	firstName := "Updated"
 	lastName := "User"
@@ -426,7 +425,7 @@ func TestDeleteUlid_Success(t *testing.T) {
 	e := echo.New()
 	mockRepo := new(setup.MockRepository[models.UserUlid])
 
-	userID := "cmk7nncf000054hz3gxgka8v9"
+	userID := "01HZX3K4Q2M8V6T9R5N7B1C0DE"
 
 	mockRepo.On("DeleteUser", userID).Return(nil)
 
@@ -466,8 +465,7 @@ func TestDeleteUlid_MissingID(t *testing.T) {
 	err := controller.DeleteUser(c)
 
 	// Assert
-	assert.Error(t, err)
-	assert.Equal(t, "id must not be null", err.Error())
+	assert.ErrorIs(t, err, repository.ErrInvalidID)
 }
 
 func TestDeleteUlid_RepositoryError(t *testing.T) {
@@ -475,7 +473,7 @@ func TestDeleteUlid_RepositoryError(t *testing.T) {
 	e := echo.New()
 	mockRepo := new(setup.MockRepository[models.UserUlid])
 
-	userID := "cmk7nncf000054hz3gxgka8v9"
+	userID := "01HZX3K4Q2M8V6T9R5N7B1C0DE"
 
 	mockRepo.On("DeleteUser", userID).Return(gorm.ErrRecordNotFound)
 
@@ -0,0 +1,118 @@
+package controller_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/theCompanyDream/id-trials/apps/backend/config"
+	"github.com/theCompanyDream/id-trials/apps/backend/controller"
+	"github.com/theCompanyDream/id-trials/apps/backend/models"
+	"github.com/theCompanyDream/id-trials/apps/backend/repository"
+	"github.com/theCompanyDream/id-trials/apps/backend/test/setup"
+)
+
+func bulkUser(userName, email string) map[string]interface{} {
+	return map[string]interface{}{
+		"user_name":  userName,
+		"first_name": "Bulk",
+		"last_name":  "Person",
+		"email":      email,
+	}
+}
+
+func decodeBatch(t *testing.T, body []byte) models.BatchResponse {
+	var response models.BatchResponse
+	require.NoError(t, json.Unmarshal(body, &response))
+	return response
+}
+
+func TestBatchUsers_PerItemResults(t *testing.T) {
+	for _, suite := range updateSuites {
+		t.Run(suite.name, func(t *testing.T) {
+			users := suite.new(setup.NewPostgresMockDB())
+
+			rec, err := callConditional(users.CreateUsers, http.MethodPost, "", echo.MIMEApplicationJSON, nil, map[string]interface{}{
+				"users": []interface{}{
+					bulkUser("bulkfirst", "first@example.com"),
+					bulkUser("bulkbad", "not-an-email"),
+					bulkUser("bulksecond", "second@example.com"),
+				},
+			})
+			require.NoError(t, err)
+			assert.Equal(t, http.StatusMultiStatus, rec.Code)
+			created := decodeBatch(t, rec.Body.Bytes())
+			assert.Equal(t, 2, created.Succeeded)
+			assert.Equal(t, 1, created.Failed)
+			require.Len(t, created.Results, 3)
+			assert.Equal(t, http.StatusCreated, created.Results[0].Status)
+			assert.Equal(t, http.StatusUnprocessableEntity, created.Results[1].Status)
+			assert.Equal(t, "bulksecond", created.Results[2].User.UserName)
+
+			ids := []string{created.Results[0].ID, suite.missingID, created.Results[2].ID}
+			rec, err = callConditional(users.GetUsersByID, http.MethodPost, "", echo.MIMEApplicationJSON, nil, map[string]interface{}{"ids": ids})
+			require.NoError(t, err)
+			read := decodeBatch(t, rec.Body.Bytes())
+			assert.Equal(t, []int{http.StatusOK, http.StatusNotFound, http.StatusOK},
+				[]int{read.Results[0].Status, read.Results[1].Status, read.Results[2].Status})
+			assert.Equal(t, "bulkfirst", read.Results[0].User.UserName)
+
+			rec, err = callConditional(users.DeleteUsers, http.MethodDelete, "", echo.MIMEApplicationJSON, nil, map[string]interface{}{"ids": ids})
+			require.NoError(t, err)
+			deleted := decodeBatch(t, rec.Body.Bytes())
+			assert.Equal(t, 2, deleted.Succeeded)
+			assert.Equal(t, http.StatusNotFound, deleted.Results[1].Status)
+		})
+	}
+}
+
+func TestBatchUsers_Atomic(t *testing.T) {
+	for _, suite := range updateSuites {
+		t.Run(suite.name, func(t *testing.T) {
+			users := suite.new(setup.NewPostgresMockDB())
+
+			_, err := callConditional(users.CreateUsers, http.MethodPost, "", echo.MIMEApplicationJSON, nil, map[string]interface{}{
+				"atomic": true,
+				"users":  []interface{}{bulkUser("bulkfirst", "first@example.com"), bulkUser("bulkbad", "not-an-email")},
+			})
+			assert.ErrorIs(t, err, repository.ErrValidation)
+
+			id := createUser(t, users)
+			_, err = callConditional(users.DeleteUsers, http.MethodDelete, "", echo.MIMEApplicationJSON, nil, map[string]interface{}{
+				"atomic": true,
+				"ids":    []string{id, suite.missingID},
+			})
+			assert.ErrorIs(t, err, repository.ErrNotFound)
+
+			// Nothing was deleted, so the user is still there
+			rec, err := callConditional(users.GetUser, http.MethodGet, id, "", nil, nil)
+			require.NoError(t, err)
+			assert.Equal(t, http.StatusOK, rec.Code)
+
+			_, err = callConditional(users.GetUsersByID, http.MethodPost, "", echo.MIMEApplicationJSON, nil, map[string]interface{}{
+				"atomic": true,
+				"ids":    []string{id, "not-an-id"},
+			})
+			assert.ErrorIs(t, err, repository.ErrInvalidID)
+		})
+	}
+}
+
+func TestBatchUsers_MaxItems(t *testing.T) {
+	cfg := config.Default()
+	cfg.Limits.BatchMaxItems = 2
+	controller.Configure(cfg)
+	t.Cleanup(func() { controller.Configure(config.Default()) })
+	users := updateSuites[0].new(setup.NewPostgresMockDB())
+
+	_, err := callConditional(users.CreateUsers, http.MethodPost, "", echo.MIMEApplicationJSON, nil, map[string]interface{}{
+		"users": []interface{}{bulkUser("bulkone", "one@example.com"), bulkUser("bulktwo", "two@example.com"), bulkUser("bulkthree", "three@example.com")},
+	})
+	assert.ErrorIs(t, err, repository.ErrValidation)
+
+	_, err = callConditional(users.DeleteUsers, http.MethodDelete, "", echo.MIMEApplicationJSON, nil, map[string]interface{}{"ids": []string{}})
+	assert.ErrorIs(t, err, repository.ErrValidation)
+}
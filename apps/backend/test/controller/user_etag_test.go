@@ -0,0 +1,117 @@
+package controller_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/theCompanyDream/id-trials/apps/backend/controller"
+	"github.com/theCompanyDream/id-trials/apps/backend/repository"
+	"github.com/theCompanyDream/id-trials/apps/backend/test/setup"
+)
+
+// callConditional invokes a handler with the given request headers and returns the recorder.
+func callConditional(handler echo.HandlerFunc, method, id, contentType string, headers map[string]string, body interface{}) (*httptest.ResponseRecorder, error) {
+	var payload []byte
+	if body != nil {
+		payload, _ = json.Marshal(body)
+	}
+	req := httptest.NewRequest(method, "/"+id, bytes.NewReader(payload))
+	if contentType != "" {
+		req.Header.Set(echo.HeaderContentType, contentType)
+	}
+	for name, value := range headers {
+		req.Header.Set(name, value)
+	}
+	rec := httptest.NewRecorder()
+	c := echo.New().NewContext(req, rec)
+	c.SetParamNames("id")
+	c.SetParamValues(id)
+	return rec, handler(c)
+}
+
+var replacement = map[string]interface{}{
+	"user_name":  "replaced",
+	"first_name": "Replaced",
+	"last_name":  "Person",
+	"email":      "replaced@example.com",
+}
+
+// currentETag reads a user and returns the ETag of its current version.
+func currentETag(t *testing.T, users controller.IUserController, id string) string {
+	rec, err := callConditional(users.GetUser, http.MethodGet, id, "", nil, nil)
+	require.NoError(t, err)
+	tag := rec.Header().Get("ETag")
+	require.NotEmpty(t, tag)
+	return tag
+}
+
+func TestGetUser_NotModified(t *testing.T) {
+	for _, suite := range updateSuites {
+		t.Run(suite.name, func(t *testing.T) {
+			users := suite.new(setup.NewPostgresMockDB())
+			id := createUser(t, users)
+			tag := currentETag(t, users, id)
+
+			rec, err := callConditional(users.GetUser, http.MethodGet, id, "", map[string]string{"If-None-Match": tag}, nil)
+			require.NoError(t, err)
+			assert.Equal(t, http.StatusNotModified, rec.Code)
+			assert.Equal(t, tag, rec.Header().Get("ETag"))
+			assert.Empty(t, rec.Body.Bytes())
+
+			rec, err = callConditional(users.GetUser, http.MethodGet, id, "", map[string]string{"If-None-Match": `"999"`}, nil)
+			require.NoError(t, err)
+			assert.Equal(t, http.StatusOK, rec.Code)
+		})
+	}
+}
+
+func TestUpdateUser_IfMatch(t *testing.T) {
+	for _, suite := range updateSuites {
+		t.Run(suite.name, func(t *testing.T) {
+			users := suite.new(setup.NewPostgresMockDB())
+			id := createUser(t, users)
+			tag := currentETag(t, users, id)
+
+			rec, err := callConditional(users.UpdateUser, http.MethodPut, id, echo.MIMEApplicationJSON, map[string]string{"If-Match": tag}, replacement)
+			require.NoError(t, err)
+			assert.Equal(t, http.StatusOK, rec.Code)
+			assert.NotEqual(t, tag, rec.Header().Get("ETag"))
+			assert.Equal(t, rec.Header().Get("ETag"), currentETag(t, users, id))
+
+			// The first write moved the version, so the old tag is stale now
+			_, err = callConditional(users.UpdateUser, http.MethodPut, id, echo.MIMEApplicationJSON, map[string]string{"If-Match": tag}, replacement)
+			assert.ErrorIs(t, err, repository.ErrPreconditionFailed)
+
+			// Weak tags never satisfy If-Match
+			_, err = callConditional(users.UpdateUser, http.MethodPut, id, echo.MIMEApplicationJSON, map[string]string{"If-Match": "W/" + currentETag(t, users, id)}, replacement)
+			assert.ErrorIs(t, err, repository.ErrPreconditionFailed)
+		})
+	}
+}
+
+func TestPatchUser_IfMatch(t *testing.T) {
+	for _, suite := range updateSuites {
+		t.Run(suite.name, func(t *testing.T) {
+			users := suite.new(setup.NewPostgresMockDB())
+			id := createUser(t, users)
+			tag := currentETag(t, users, id)
+			patch := map[string]interface{}{"department": "Sales"}
+
+			rec, err := callConditional(users.PatchUser, http.MethodPatch, id, controller.MIMEApplicationMergePatchJSON, map[string]string{"If-Match": tag}, patch)
+			require.NoError(t, err)
+			assert.NotEqual(t, tag, rec.Header().Get("ETag"))
+
+			_, err = callConditional(users.PatchUser, http.MethodPatch, id, controller.MIMEApplicationMergePatchJSON, map[string]string{"If-Match": tag}, patch)
+			assert.ErrorIs(t, err, repository.ErrPreconditionFailed)
+
+			_, err = callConditional(users.PatchUser, http.MethodPatch, id, controller.MIMEApplicationMergePatchJSON, map[string]string{"If-Match": "*"}, patch)
+			assert.NoError(t, err)
+		})
+	}
+}
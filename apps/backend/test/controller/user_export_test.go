@@ -0,0 +1,79 @@
+package controller_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/theCompanyDream/id-trials/apps/backend/controller"
+	"github.com/theCompanyDream/id-trials/apps/backend/models"
+	"github.com/theCompanyDream/id-trials/apps/backend/test/setup"
+)
+
+func exportRequest(handler echo.HandlerFunc, accept, query string) (*httptest.ResponseRecorder, error) {
+	req := httptest.NewRequest(http.MethodGet, "/export"+query, nil)
+	if accept != "" {
+		req.Header.Set(echo.HeaderAccept, accept)
+	}
+	rec := httptest.NewRecorder()
+	return rec, handler(echo.New().NewContext(req, rec))
+}
+
+func TestExportUsers_Formats(t *testing.T) {
+	for _, suite := range updateSuites {
+		t.Run(suite.name, func(t *testing.T) {
+			users := suite.new(setup.NewPostgresMockDB())
+			first := createUser(t, users)
+			second := createUser(t, users)
+
+			rec, err := exportRequest(users.ExportUsers, "", "")
+			require.NoError(t, err)
+			assert.Equal(t, http.StatusOK, rec.Code)
+			assert.True(t, strings.HasPrefix(rec.Header().Get(echo.HeaderContentType), controller.MIMEApplicationNDJSON))
+			lines := strings.Split(strings.TrimSpace(rec.Body.String()), "\n")
+			require.Len(t, lines, 2)
+			var user models.UserDTO
+			require.NoError(t, json.Unmarshal([]byte(lines[0]), &user))
+			assert.Contains(t, []string{first, second}, user.ID)
+			assert.Equal(t, "original", user.UserName)
+
+			rec, err = exportRequest(users.ExportUsers, "text/csv", "")
+			require.NoError(t, err)
+			assert.True(t, strings.HasPrefix(rec.Header().Get(echo.HeaderContentType), "text/csv"))
+			lines = strings.Split(strings.TrimSpace(rec.Body.String()), "\n")
+			require.Len(t, lines, 3)
+			assert.Equal(t, "id,user_name,first_name,last_name,email,department,version", lines[0])
+			assert.Contains(t, lines[1], "original,Original,Person,original@example.com,Engineering,1")
+		})
+	}
+}
+
+func TestExportUsers_EmptyTable(t *testing.T) {
+	users := controller.NewUlidController(setup.NewPostgresMockDB())
+
+	rec, err := exportRequest(users.ExportUsers, "text/csv", "")
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "id,user_name,first_name,last_name,email,department,version\n", rec.Body.String())
+}
+
+func TestExportUsers_SearchAndNegotiation(t *testing.T) {
+	mockRepo := new(setup.MockRepository[models.UserUlid])
+	mockRepo.On("ExportUsers", "jane").Return([]models.UserDTO{{ID: "01HZX3K4Q2M8V6T9R5N7B1C0DE", UserName: "jane"}}, nil)
+	users := &controller.UsersUlidControllers{Repo: mockRepo}
+
+	rec, err := exportRequest(users.ExportUsers, "application/x-ndjson", "?search=jane")
+	require.NoError(t, err)
+	assert.Contains(t, rec.Body.String(), `"user_name":"jane"`)
+	mockRepo.AssertExpectations(t)
+
+	_, err = exportRequest(users.ExportUsers, "application/xml", "")
+	var httpErr *echo.HTTPError
+	require.ErrorAs(t, err, &httpErr)
+	assert.Equal(t, http.StatusNotAcceptable, httpErr.Code)
+}
@@ -0,0 +1,164 @@
+package controller_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/theCompanyDream/id-trials/apps/backend/controller"
+	"github.com/theCompanyDream/id-trials/apps/backend/repository"
+	"github.com/theCompanyDream/id-trials/apps/backend/test/setup"
+	"gorm.io/gorm"
+)
+
+// updateSuites runs the PUT and PATCH tests below against every ID type, so
+// replacement and merge patch semantics cannot drift between them.
+var updateSuites = []struct {
+	name      string
+	new       func(db *gorm.DB) controller.IUserController
+	missingID string
+}{
+	{"ULID", controller.NewUlidController, "01HZX3K4Q2M8V6T9R5N7B1C0DE"},
+	{"UUID", controller.NewGormUuidController, "f47ac10b-58cc-4372-a567-0e02b2c3d479"},
+	{"KSUID", controller.NewGormKsuidController, "2ZQ1gR7H4fXk9sLmN3pT6vYw8bC"},
+	{"CUID", controller.NewGormCuidController, "cmk7nncf000054hz3gxgka8v9"},
+	{"NanoID", controller.NewGormNanoController, "V1StGXR8_Z5jdHi6B-myT"},
+	{"Snowflake", controller.NewSnowCuidController, "1234567890123456789"},
+}
+
+// callUser invokes a handler with a JSON body and returns its decoded response and error.
+func callUser(handler echo.HandlerFunc, method, id, contentType string, body interface{}) (map[string]interface{}, error) {
+	payload, _ := json.Marshal(body)
+	req := httptest.NewRequest(method, "/"+id, bytes.NewReader(payload))
+	req.Header.Set(echo.HeaderContentType, contentType)
+	rec := httptest.NewRecorder()
+	c := echo.New().NewContext(req, rec)
+	if id != "" {
+		c.SetParamNames("id")
+		c.SetParamValues(id)
+	}
+
+	err := handler(c)
+
+	var response map[string]interface{}
+	decoder := json.NewDecoder(rec.Body)
+	decoder.UseNumber()
+	decoder.Decode(&response)
+	return response, err
+}
+
+// createUser stores a user with every field set and returns its ID.
+func createUser(t *testing.T, users controller.IUserController) string {
+	created, err := callUser(users.CreateUser, http.MethodPost, "", echo.MIMEApplicationJSON, map[string]interface{}{
+		"user_name":  "original",
+		"first_name": "Original",
+		"last_name":  "Person",
+		"email":      "original@example.com",
+		"department": "Engineering",
+	})
+	require.NoError(t, err)
+	return fmt.Sprint(created["id"])
+}
+
+func TestUpdateUser_ReplacesEveryField(t *testing.T) {
+	for _, suite := range updateSuites {
+		t.Run(suite.name, func(t *testing.T) {
+			users := suite.new(setup.NewPostgresMockDB())
+			id := createUser(t, users)
+
+			updated, err := callUser(users.UpdateUser, http.MethodPut, id, echo.MIMEApplicationJSON, map[string]interface{}{
+				"user_name":  "replaced",
+				"first_name": "Replaced",
+				"last_name":  "Person",
+				"email":      "replaced@example.com",
+			})
+
+			require.NoError(t, err)
+			assert.Equal(t, "replaced", updated["user_name"])
+			assert.Equal(t, "replaced@example.com", updated["email"])
+			assert.Nil(t, updated["department"], "an omitted department is cleared")
+		})
+	}
+}
+
+func TestUpdateUser_RequiresEveryField(t *testing.T) {
+	for _, suite := range updateSuites {
+		t.Run(suite.name, func(t *testing.T) {
+			users := suite.new(setup.NewPostgresMockDB())
+			id := createUser(t, users)
+
+			_, err := callUser(users.UpdateUser, http.MethodPut, id, echo.MIMEApplicationJSON, map[string]interface{}{
+				"first_name": "Only",
+			})
+
+			var domainErr *repository.DomainError
+			require.ErrorAs(t, err, &domainErr)
+			assert.Equal(t, repository.KindValidation, domainErr.Kind)
+			assert.Contains(t, domainErr.Fields, "UserName")
+			assert.Contains(t, domainErr.Fields, "Email")
+		})
+	}
+}
+
+func TestPatchUser_MergesFields(t *testing.T) {
+	for _, suite := range updateSuites {
+		t.Run(suite.name, func(t *testing.T) {
+			users := suite.new(setup.NewPostgresMockDB())
+			id := createUser(t, users)
+
+			// Set one field and leave the rest alone
+			patched, err := callUser(users.PatchUser, http.MethodPatch, id, controller.MIMEApplicationMergePatchJSON, map[string]interface{}{
+				"first_name": "Patched",
+			})
+			require.NoError(t, err)
+			assert.Equal(t, "Patched", patched["first_name"])
+			assert.Equal(t, "original", patched["user_name"])
+			assert.Equal(t, "Engineering", patched["department"])
+
+			// Clear an optional field explicitly
+			patched, err = callUser(users.PatchUser, http.MethodPatch, id, controller.MIMEApplicationMergePatchJSON, map[string]interface{}{
+				"department": nil,
+			})
+			require.NoError(t, err)
+			assert.Nil(t, patched["department"])
+			assert.Equal(t, "Patched", patched["first_name"])
+		})
+	}
+}
+
+func TestPatchUser_Rejections(t *testing.T) {
+	for _, suite := range updateSuites {
+		t.Run(suite.name, func(t *testing.T) {
+			users := suite.new(setup.NewPostgresMockDB())
+			id := createUser(t, users)
+
+			// Required fields cannot be cleared
+			_, err := callUser(users.PatchUser, http.MethodPatch, id, controller.MIMEApplicationMergePatchJSON, map[string]interface{}{
+				"user_name": nil,
+			})
+			assert.ErrorIs(t, err, repository.ErrValidation)
+
+			// The ID is immutable
+			_, err = callUser(users.PatchUser, http.MethodPatch, id, controller.MIMEApplicationMergePatchJSON, map[string]interface{}{
+				"id": suite.missingID,
+			})
+			assert.ErrorIs(t, err, repository.ErrValidation)
+
+			// Patching a user that does not exist
+			_, err = callUser(users.PatchUser, http.MethodPatch, suite.missingID, controller.MIMEApplicationMergePatchJSON, map[string]interface{}{
+				"first_name": "Nobody",
+			})
+			assert.ErrorIs(t, err, repository.ErrNotFound)
+
+			// Only JSON bodies are merge patches
+			_, err = callUser(users.PatchUser, http.MethodPatch, id, echo.MIMETextPlain, map[string]interface{}{})
+			assert.ErrorIs(t, err, echo.ErrUnsupportedMediaType)
+		})
+	}
+}
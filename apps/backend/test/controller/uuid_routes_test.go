@@ -12,6 +12,7 @@ import (
 	"github.com/stretchr/testify/mock"
 	"github.com/theCompanyDream/id-trials/apps/backend/controller"
 	"github.com/theCompanyDream/id-trials/apps/backend/models"
+	"github.com/theCompanyDream/id-trials/apps/backend/repository"
 	"github.com/theCompanyDream/id-trials/apps/backend/test/setup"
 	"gorm.io/gorm"
 )
@@ -24,7 +25,7 @@ func TestGetUuid_Success(t *testing.T) {
 	department := "Engineering"
 
 	expectedUser := &models.UserUUID{
-		ID: "cmk7nncf000054hz3gxgka8v9",
+		ID: "f47ac10b-58cc-4372-a567-0e02b2c3d479",
 		UserBase: &models.UserBase{
 			UserName:   "testuser",
 			FirstName:  "Test",
@@ -34,13 +35,13 @@ func TestGetUuid_Success(t *testing.T) {
 		},
 	}
 
-	mockRepo.On("GetUser", "cmk7nncf000054hz3gxgka8v9").Return(expectedUser, nil)
+	mockRepo.On("GetUser", "f47ac10b-58cc-4372-a567-0e02b2c3d479").Return(expectedUser, nil)
 
-	req := httptest.NewRequest(http.MethodGet, "/uuid/cmk7nncf000054hz3gxgka8v9", nil)
+	req := httptest.NewRequest(http.MethodGet, "/uuid/f47ac10b-58cc-4372-a567-0e02b2c3d479", nil)
 	rec := httptest.NewRecorder()
 	c := e.NewContext(req, rec)
 	c.SetParamNames("id")
-	c.SetParamValues("cmk7nncf000054hz3gxgka8v9")
+	c.SetParamValues("f47ac10b-58cc-4372-a567-0e02b2c3d479")
 
 	// ✅ Inject the mock into the controller
 	controller := &controller.UuidUsersController{
@@ -67,13 +68,13 @@ func TestGetUuid_NotFound(t *testing.T) {
 	e := echo.New()
 	mockRepo := new(setup.MockRepository[models.UserUUID])
 
-	mockRepo.On("GetUser", "invalid-id").Return(nil, gorm.ErrRecordNotFound)
+	mockRepo.On("GetUser", "9b2e6f0a-3c1d-4e8f-a2b7-5d6c8e9f0a1b").Return(nil, gorm.ErrRecordNotFound)
 
-	req := httptest.NewRequest(http.MethodGet, "/uuid/invalid-id", nil)
+	req := httptest.NewRequest(http.MethodGet, "/uuid/9b2e6f0a-3c1d-4e8f-a2b7-5d6c8e9f0a1b", nil)
 	rec := httptest.NewRecorder()
 	c := e.NewContext(req, rec)
 	c.SetParamNames("id")
-	c.SetParamValues("invalid-id")
+	c.SetParamValues("9b2e6f0a-3c1d-4e8f-a2b7-5d6c8e9f0a1b")
 
 	controller := &controller.UuidUsersController{
 		Repo: mockRepo, // Set the repo field directly
@@ -104,8 +105,7 @@ func TestGetUuid_MissingID(t *testing.T) {
 	err := controller.GetUser(c)
 
 	// Assert
-	assert.NoError(t, err) // Returns JSON error, not Go error
-	assert.Equal(t, http.StatusNotFound, rec.Code)
+	assert.ErrorIs(t, err, repository.ErrInvalidID)
 }
 
 // TestGetUsers tests the GetUsers endpoint
@@ -268,7 +268,7 @@ func TestCreateUuid_Success(t *testing.T) {
 	}
 
 	createdUser := &models.UserUUID{
-		ID: "cmk7nncf000054hz3gxgka8v9",
+		ID: "f47ac10b-58cc-4372-a567-0e02b2c3d479",
 		UserBase: &models.UserBase{
 			UserName:   userName,
 			FirstName:  firstName,
@@ -331,8 +331,7 @@ func TestCreateUuid_ValidationError(t *testing.T) {
 	err := controller.CreateUser(c)
 
 	// Assert
-	assert.NoError(t, err) // Returns JSON with validation errors
-	assert.Equal(t, http.StatusUnprocessableEntity, rec.Code)
+	assert.ErrorIs(t, err, repository.ErrValidation)
 }
 
 func TestCreateUuid_InvalidJSON(t *testing.T) {
@@ -362,7 +361,7 @@ func TestUpdateUuid_Success(t *testing.T) {
 	e := echo.New()
 	mockRepo := new(setup.MockRepository[models.UserUUID])
 
-	userID := "cmk7nncf000054hz3gxgka8v9"
+	userID := "f47ac10b-58cc-4372-a567-0e02b2c3d479"
 	userName := "updateduser"
 	firstName := "Updated"
 	lastName := "User"
@@ -423,7 +422,7 @@ func TestDeleteUuid_Success(t *testing.T) {
 	e := echo.New()
 	mockRepo := new(setup.MockRepository[models.UserUUID])
 
-	userID := "cmk7nncf000054hz3gxgka8v9"
+	userID := "f47ac10b-58cc-4372-a567-0e02b2c3d479"
 
 	mockRepo.On("DeleteUser", userID).Return(nil)
 
@@ -463,8 +462,7 @@ func TestDeleteUuid_MissingID(t *testing.T) {
 	err := controller.DeleteUser(c)
 
 	// Assert
-	assert.Error(t, err)
-	assert.Equal(t, "id must not be null", err.Error())
+	assert.ErrorIs(t, err, repository.ErrInvalidID)
 }
 
 func TestDeleteUuid_RepositoryError(t *testing.T) {
@@ -472,7 +470,7 @@ func TestDeleteUuid_RepositoryError(t *testing.T) {
 	e := echo.New()
 	mockRepo := new(setup.MockRepository[models.UserUUID])
 
-	userID := "cmk7nncf000054hz3gxgka8v9"
+	userID := "f47ac10b-58cc-4372-a567-0e02b2c3d479"
 
 	mockRepo.On("DeleteUser", userID).Return(gorm.ErrRecordNotFound)
 
@@ -66,7 +66,7 @@ func TestMiddlewareIDTypeExtraction(t *testing.T) {
 	defer setup.CleanupDB(t, db)
 
 	e := echo.New()
-	metricsMiddleware := middleware.NewMetricsMiddleware(db)
+	metricsMiddleware := middleware.NewMetricsMiddleware(db, false)
 	e.Use(metricsMiddleware.CaptureMetrics())
 
 	// Test different ID types
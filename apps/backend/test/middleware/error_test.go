@@ -0,0 +1,68 @@
+package middleware
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/theCompanyDream/id-trials/apps/backend/middleware"
+	"github.com/theCompanyDream/id-trials/apps/backend/models"
+	"github.com/theCompanyDream/id-trials/apps/backend/repository"
+)
+
+func TestHttpErrorHandler_ProblemDetails(t *testing.T) {
+	tests := []struct {
+		name   string
+		err    error
+		status int
+		detail string
+	}{
+		{"not found", repository.NotFound("user", "01HZX3K4Q2M8V6T9R5N7B1C0DE"), http.StatusNotFound, "user 01HZX3K4Q2M8V6T9R5N7B1C0DE not found"},
+		{"conflict", &repository.DomainError{Kind: repository.KindConflict, Message: "user already exists"}, http.StatusConflict, "user already exists"},
+		{"invalid id", repository.InvalidID(map[string]string{"id": "bad"}), http.StatusBadRequest, "invalid id"},
+		{"validation", repository.Validation(map[string]string{"Email": "bad"}), http.StatusUnprocessableEntity, "validation failed"},
+		{"precondition failed", repository.PreconditionFailed("user", "01HZX3K4Q2M8V6T9R5N7B1C0DE"), http.StatusPreconditionFailed, "user 01HZX3K4Q2M8V6T9R5N7B1C0DE has been modified"},
+		{"echo error", echo.NewHTTPError(http.StatusBadRequest, "malformed body"), http.StatusBadRequest, "malformed body"},
+		{"unknown error", errors.New("connection reset"), http.StatusInternalServerError, "Internal server error"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, "/ulidId/01HZX3K4Q2M8V6T9R5N7B1C0DE", nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.Response().Header().Set(echo.HeaderXRequestID, "req-123")
+
+			middleware.HttpErrorHandler(tt.err, c)
+
+			assert.Equal(t, tt.status, rec.Code)
+			assert.Equal(t, middleware.MIMEApplicationProblemJSON, rec.Header().Get(echo.HeaderContentType))
+
+			var problem models.Problem
+			assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &problem))
+			assert.Equal(t, tt.status, problem.Status)
+			assert.Equal(t, http.StatusText(tt.status), problem.Title)
+			assert.Equal(t, tt.detail, problem.Detail)
+			assert.Equal(t, "/ulidId/01HZX3K4Q2M8V6T9R5N7B1C0DE", problem.Instance)
+			assert.Equal(t, "req-123", problem.RequestID)
+		})
+	}
+}
+
+func TestHttpErrorHandler_FieldErrors(t *testing.T) {
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodPut, "/ulidId/nope", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	middleware.HttpErrorHandler(repository.InvalidID(map[string]string{"id": "Field validation for 'id' failed on the 'ulid' tag"}), c)
+
+	var problem models.Problem
+	assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &problem))
+	assert.Equal(t, "Field validation for 'id' failed on the 'ulid' tag", problem.Errors["id"])
+}
@@ -0,0 +1,77 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/theCompanyDream/id-trials/apps/backend/middleware"
+	"github.com/theCompanyDream/id-trials/apps/backend/repository"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestNewStatus(t *testing.T) {
+	tests := []struct {
+		name    string
+		err     error
+		code    codes.Code
+		message string
+	}{
+		{"not found", repository.NotFound("user", "01HZX3K4Q2M8V6T9R5N7B1C0DE"), codes.NotFound, "user 01HZX3K4Q2M8V6T9R5N7B1C0DE not found"},
+		{"conflict", &repository.DomainError{Kind: repository.KindConflict, Message: "user already exists"}, codes.AlreadyExists, "user already exists"},
+		{"invalid id", repository.InvalidID(map[string]string{"id": "bad"}), codes.InvalidArgument, "invalid id"},
+		{"validation", repository.Validation(map[string]string{"Email": "bad"}), codes.InvalidArgument, "validation failed"},
+		{"precondition failed", repository.PreconditionFailed("user", "01HZX3K4Q2M8V6T9R5N7B1C0DE"), codes.FailedPrecondition, "user 01HZX3K4Q2M8V6T9R5N7B1C0DE has been modified"},
+		{"status", status.Error(codes.Unavailable, "try later"), codes.Unavailable, "try later"},
+		{"unknown error", errors.New("connection reset"), codes.Internal, "Internal server error"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := middleware.NewStatus(tt.err)
+			assert.Equal(t, tt.code, s.Code())
+			assert.Equal(t, tt.message, s.Message())
+		})
+	}
+}
+
+func TestNewStatus_FieldViolations(t *testing.T) {
+	s := middleware.NewStatus(repository.Validation(map[string]string{"LastName": "required", "Email": "email"}))
+
+	require.Len(t, s.Details(), 1)
+	badRequest, ok := s.Details()[0].(*errdetails.BadRequest)
+	require.True(t, ok)
+	require.Len(t, badRequest.GetFieldViolations(), 2)
+	assert.Equal(t, "Email", badRequest.GetFieldViolations()[0].GetField())
+	assert.Equal(t, "email", badRequest.GetFieldViolations()[0].GetDescription())
+	assert.Equal(t, "LastName", badRequest.GetFieldViolations()[1].GetField())
+}
+
+func TestGRPCErrorHandler_RecoversPanics(t *testing.T) {
+	info := &grpc.UnaryServerInfo{FullMethod: "/idtrials.v1.UlidUsers/GetUser"}
+	_, err := middleware.GRPCErrorHandler(context.Background(), nil, info, func(context.Context, interface{}) (interface{}, error) {
+		panic("boom")
+	})
+	assert.Equal(t, codes.Internal, status.Code(err))
+	assert.Equal(t, "Internal server error", status.Convert(err).Message())
+}
+
+func TestExtractIDType_GRPCMethods(t *testing.T) {
+	for method, idType := range map[string]string{
+		"/idtrials.v1.UlidUsers/GetUser":        "ULID",
+		"/idtrials.v1.UuidUsers/ListUsers":      "UUID",
+		"/idtrials.v1.KsuidUsers/CreateUser":    "KSUID",
+		"/idtrials.v1.CuidUsers/UpdateUser":     "CUID",
+		"/idtrials.v1.NanoidUsers/DeleteUser":   "NanoID",
+		"/idtrials.v1.SnowflakeUsers/GetUser":   "Snowflake",
+		"/grpc.health.v1.Health/Check":          "Unknown",
+		"/grpc.reflection.v1.ServerReflection/": "Unknown",
+	} {
+		assert.Equal(t, idType, middleware.ExtractIDType(method), method)
+	}
+}
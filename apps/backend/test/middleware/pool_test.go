@@ -0,0 +1,74 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/theCompanyDream/id-trials/apps/backend/middleware"
+	"github.com/theCompanyDream/id-trials/apps/backend/models"
+	"github.com/theCompanyDream/id-trials/apps/backend/test/setup"
+)
+
+func TestCaptureMetricsRecordsPoolWaits(t *testing.T) {
+	db := setup.NewPostgresMockDB()
+	sqlDB, err := db.DB()
+	require.NoError(t, err)
+	sqlDB.SetMaxOpenConns(1)
+
+	e := echo.New()
+	e.Use(middleware.NewMetricsMiddleware(db, false).CaptureMetrics())
+	e.GET("/ulidIds", func(c echo.Context) error {
+		// Hold the only connection while a query waits for it
+		conn, err := sqlDB.Conn(context.Background())
+		if err != nil {
+			return err
+		}
+		var wg sync.WaitGroup
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			var count int64
+			db.Model(&models.UserUlid{}).Count(&count)
+		}()
+		time.Sleep(50 * time.Millisecond)
+		conn.Close()
+		wg.Wait()
+		return c.String(http.StatusOK, "test")
+	})
+
+	e.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/ulidIds", nil))
+
+	var metric models.RouteMetric
+	require.Eventually(t, func() bool {
+		return db.Last(&metric).Error == nil
+	}, time.Second, 10*time.Millisecond)
+	assert.Equal(t, 1, metric.PoolMaxOpen)
+	assert.Equal(t, 0, metric.PoolInUse)
+	assert.Equal(t, int64(1), metric.PoolWaitCount)
+	assert.Greater(t, metric.PoolWaitDuration, 0.0)
+}
+
+func TestPoolSamplerSample(t *testing.T) {
+	db := setup.NewPostgresMockDB()
+	sqlDB, err := db.DB()
+	require.NoError(t, err)
+	sqlDB.SetMaxOpenConns(3)
+
+	sampler := middleware.NewPoolSampler(db, time.Minute)
+	require.NoError(t, sampler.Sample())
+	require.NoError(t, sampler.Sample())
+
+	var samples []models.PoolStat
+	require.NoError(t, db.Order("id").Find(&samples).Error)
+	require.Len(t, samples, 2)
+	assert.Equal(t, 3, samples[0].MaxOpen)
+	assert.GreaterOrEqual(t, samples[1].Open, 1)
+	assert.False(t, samples[1].Timestamp.Before(samples[0].Timestamp))
+}
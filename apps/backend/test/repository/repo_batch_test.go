@@ -0,0 +1,86 @@
+package repository
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/theCompanyDream/id-trials/apps/backend/models"
+	"github.com/theCompanyDream/id-trials/apps/backend/repository"
+	"github.com/theCompanyDream/id-trials/apps/backend/test/setup"
+)
+
+func batchOfUlidUsers(count int) []models.UserUlid {
+	users := make([]models.UserUlid, 0, count)
+	for i := 0; i < count; i++ {
+		users = append(users, models.UserUlid{
+			UserBase: &models.UserBase{
+				UserName:  fmt.Sprintf("bulkuser%d", i),
+				FirstName: "Bulk",
+				LastName:  "User",
+				Email:     fmt.Sprintf("bulk%d@example.com", i),
+			},
+		})
+	}
+	return users
+}
+
+// TestBulkUlidUsers creates, reads and deletes users in bulk.
+func TestBulkUlidUsers(t *testing.T) {
+	db := setup.NewPostgresMockDB()
+	ulidRepository := repository.NewGormUlidRepository(db)
+
+	results, err := ulidRepository.CreateUsers(batchOfUlidUsers(3), false)
+	require.NoError(t, err)
+	require.Len(t, results, 3)
+
+	ids := make([]string, 0, len(results))
+	for i, result := range results {
+		require.NoError(t, result.Err)
+		require.NotEmpty(t, result.User.ID, "every user should get an ID")
+		assert.Equal(t, fmt.Sprintf("bulkuser%d", i), result.User.UserName, "results should keep request order")
+		ids = append(ids, result.User.ID)
+	}
+
+	found, err := ulidRepository.GetUsersByID(append(ids, "01HZX3K4Q2M8V6T9R5N7B1C0DE"))
+	require.NoError(t, err)
+	assert.Len(t, found, 3, "missing IDs should be left out")
+
+	// An atomic delete with a missing ID deletes nothing
+	_, err = ulidRepository.DeleteUsers([]string{ids[0], "01HZX3K4Q2M8V6T9R5N7B1C0DE"}, true)
+	assert.ErrorIs(t, err, repository.ErrNotFound)
+	_, err = ulidRepository.GetUser(ids[0])
+	assert.NoError(t, err, "atomic delete should have rolled back")
+
+	deleted, err := ulidRepository.DeleteUsers([]string{ids[0], ids[1], "01HZX3K4Q2M8V6T9R5N7B1C0DE"}, false)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{ids[0], ids[1]}, deleted)
+
+	found, err = ulidRepository.GetUsersByID(ids)
+	require.NoError(t, err)
+	require.Len(t, found, 1)
+	assert.Equal(t, ids[2], found[0].ID)
+}
+
+// TestBulkSnowUsers checks that integer IDs round-trip through the string based bulk methods.
+func TestBulkSnowUsers(t *testing.T) {
+	db := setup.NewPostgresMockDB()
+	snowRepository := repository.NewGormSnowRepository(db)
+
+	users := []models.UserSnowflake{
+		{UserBase: &models.UserBase{UserName: "snowbulk1", FirstName: "Snow", LastName: "Bulk", Email: "snow1@example.com"}},
+		{UserBase: &models.UserBase{UserName: "snowbulk2", FirstName: "Snow", LastName: "Bulk", Email: "snow2@example.com"}},
+	}
+	results, err := snowRepository.CreateUsers(users, true)
+	require.NoError(t, err)
+
+	ids := []string{fmt.Sprint(results[0].User.ID), fmt.Sprint(results[1].User.ID)}
+	found, err := snowRepository.GetUsersByID(ids)
+	require.NoError(t, err)
+	assert.Len(t, found, 2)
+
+	deleted, err := snowRepository.DeleteUsers(ids, true)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, ids, deleted)
+}
@@ -0,0 +1,85 @@
+package repository
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/theCompanyDream/id-trials/apps/backend/models"
+	"github.com/theCompanyDream/id-trials/apps/backend/repository"
+	"github.com/theCompanyDream/id-trials/apps/backend/test/setup"
+)
+
+// writeBenchmark is a batch of 100 inserts whose counters give round ratios.
+func writeBenchmark(runID, idType string, at time.Time) *models.WriteBenchmark {
+	return &models.WriteBenchmark{
+		RunID:            runID,
+		IDType:           idType,
+		Table:            "users_" + idType,
+		Records:          100,
+		Seed:             42,
+		WalBytes:         12345,
+		WalRecords:       300,
+		WalFpi:           7,
+		IndexPagesBefore: 10,
+		IndexPagesAfter:  14,
+		IndexBlocksRead:  5,
+		IndexBlocksHit:   95,
+		HeapBlocksRead:   15,
+		HeapBlocksHit:    85,
+		Duration:         12.5,
+		Timestamp:        at,
+	}
+}
+
+func TestSaveWriteBenchmark(t *testing.T) {
+	db := setup.NewPostgresMockDB()
+	result := writeBenchmark("run-1", "ULID", time.Now())
+	require.NoError(t, repository.NewBenchmarkRepository(db).SaveWriteBenchmark(result))
+	assert.NotZero(t, result.ID)
+
+	var saved models.WriteBenchmark
+	require.NoError(t, db.First(&saved, result.ID).Error)
+	assert.Equal(t, "users_ULID", saved.Table)
+	assert.Equal(t, int64(42), saved.Seed)
+	assert.Equal(t, int64(12345), saved.WalBytes)
+	assert.Equal(t, int64(14), saved.IndexPagesAfter)
+}
+
+// TestGetWriteAmplification checks that only the latest benchmark of each ID
+// type is reported, with its counters turned into per-insert ratios.
+func TestGetWriteAmplification(t *testing.T) {
+	db := setup.NewPostgresMockDB()
+	benchRepo := repository.NewBenchmarkRepository(db)
+	now := time.Now().UTC()
+
+	stale := writeBenchmark("run-1", "ULID", now.Add(-time.Hour))
+	stale.WalBytes = 99999
+	require.NoError(t, benchRepo.SaveWriteBenchmark(stale))
+	require.NoError(t, benchRepo.SaveWriteBenchmark(writeBenchmark("run-2", "ULID", now)))
+	untouched := writeBenchmark("run-2", "UUID", now)
+	untouched.IndexBlocksRead, untouched.IndexBlocksHit, untouched.HeapBlocksRead, untouched.HeapBlocksHit = 0, 0, 0, 0
+	require.NoError(t, benchRepo.SaveWriteBenchmark(untouched))
+
+	results, err := repository.NewMetricsRepository(db).GetWriteAmplification()
+	require.NoError(t, err)
+	require.Len(t, results, 2, "one row per ID type")
+
+	ulid := results[0]
+	assert.Equal(t, "ULID", ulid.IDType)
+	assert.Equal(t, "run-2", ulid.RunID, "the latest run wins")
+	assert.Equal(t, "users_ULID", ulid.TableName)
+	assert.Equal(t, int64(100), ulid.Records)
+	assert.InDelta(t, 123.45, ulid.WalBytesPerInsert, 0.001)
+	assert.InDelta(t, 0.07, ulid.WalFpiPerInsert, 0.0001)
+	assert.Equal(t, int64(100), ulid.IndexBlocksTouched)
+	assert.Equal(t, int64(4), ulid.IndexPagesAdded)
+	assert.InDelta(t, 90.0, ulid.BufferHitRatio, 0.001)
+	assert.InDelta(t, 12.5, ulid.Duration, 0.001)
+	assert.WithinDuration(t, now, ulid.Timestamp, time.Second)
+
+	assert.Equal(t, "UUID", results[1].IDType)
+	assert.InDelta(t, 100.0, results[1].BufferHitRatio, 0.001, "no blocks touched counts as all hits")
+}
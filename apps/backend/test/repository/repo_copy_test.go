@@ -0,0 +1,38 @@
+package repository
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/theCompanyDream/id-trials/apps/backend/models"
+	"github.com/theCompanyDream/id-trials/apps/backend/repository"
+	"github.com/theCompanyDream/id-trials/apps/backend/test/setup"
+)
+
+func TestCopyRows(t *testing.T) {
+	db := setup.NewPostgresMockDB()
+	department := "Engineering"
+	users := []models.UserSnowflake{
+		{ID: 1, UserBase: &models.UserBase{UserName: "ada", FirstName: "Ada", LastName: "Lovelace", Email: "ada@example.com", Department: &department}},
+		{ID: 2, UserBase: &models.UserBase{UserName: "grace", FirstName: "Grace", LastName: "Hopper", Email: "grace@example.com"}},
+	}
+
+	table, columns, data, err := repository.CopyRows(db, users)
+	require.NoError(t, err)
+	assert.Equal(t, "users_snowflake", table)
+	assert.Equal(t, []string{"id", "user_name", "first_name", "last_name", "email", "department"}, columns,
+		"an unset version is left to its column default")
+	require.Len(t, data, 2)
+	assert.Equal(t, int64(2), data[1][0])
+	assert.Equal(t, "grace", data[1][1])
+}
+
+func TestCopyFromNeedsPostgres(t *testing.T) {
+	db := setup.NewPostgresMockDB()
+	users := []models.UserUlid{{ID: "01HZX3K4Q2M8V6T9R5N7B1C0DE", UserBase: &models.UserBase{UserName: "ada"}}}
+
+	_, err := repository.CopyFrom(db, users)
+	assert.ErrorIs(t, err, repository.ErrCopyUnsupported)
+}
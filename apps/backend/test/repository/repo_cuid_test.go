@@ -118,6 +118,7 @@ func TestUpdateCuid(t *testing.T) {
 	created.FirstName = "John"
 	updated, err := cuidRepository.UpdateUser(*created)
 	require.NoError(t, err, "failed to update user")
+	created.Version = updated.Version // later updates must carry the current version
 	assert.Equal(t, created.FirstName, updated.FirstName, "first name should be updated")
 	assert.Equal(t, created.UserName, user.UserName, "user name should match")
 	assert.Equal(t, created.FirstName, user.FirstName, "first should match")
@@ -128,6 +129,7 @@ func TestUpdateCuid(t *testing.T) {
 	created.LastName = "Gotti"
 	updated, err = cuidRepository.UpdateUser(*created)
 	require.NoError(t, err, "failed to update user")
+	created.Version = updated.Version
 	assert.Equal(t, created.FirstName, updated.FirstName, "first name should be updated")
 	assert.Equal(t, created.UserName, user.UserName, "user name should match")
 	assert.Equal(t, created.FirstName, user.FirstName, "first should match")
@@ -138,6 +140,7 @@ func TestUpdateCuid(t *testing.T) {
 	created.Email = "georgegotti@example.com"
 	updated, err = cuidRepository.UpdateUser(*created)
 	require.NoError(t, err, "failed to update user")
+	created.Version = updated.Version
 	assert.Equal(t, created.FirstName, updated.FirstName, "first name should be updated")
 	assert.Equal(t, created.UserName, user.UserName, "user name should match")
 	assert.Equal(t, created.FirstName, user.FirstName, "first should match")
@@ -149,6 +152,7 @@ func TestUpdateCuid(t *testing.T) {
 	created.Department = &deparment
 	updated, err = cuidRepository.UpdateUser(*created)
 	require.NoError(t, err, "failed to update user")
+	created.Version = updated.Version
 	assert.Equal(t, created.FirstName, updated.FirstName, "first name should be updated")
 	assert.Equal(t, created.UserName, user.UserName, "user name should match")
 	assert.Equal(t, created.FirstName, user.FirstName, "first should match")
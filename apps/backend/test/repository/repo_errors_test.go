@@ -0,0 +1,32 @@
+package repository
+
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/theCompanyDream/id-trials/apps/backend/repository"
+)
+
+func TestIsTransient(t *testing.T) {
+	for _, test := range []struct {
+		err       error
+		transient bool
+	}{
+		{&pgconn.PgError{Code: "08006"}, true}, // connection_failure
+		{&pgconn.PgError{Code: "40001"}, true}, // serialization_failure
+		{&pgconn.PgError{Code: "40P01"}, true}, // deadlock_detected
+		{&pgconn.PgError{Code: "57P01"}, true}, // admin_shutdown
+		{fmt.Errorf("insert batch: %w", driver.ErrBadConn), true},
+		{&pgconn.PgError{Code: "23505"}, false}, // unique_violation
+		{&pgconn.PgError{Code: "53100"}, false}, // disk_full
+		{errors.New("disk full"), false},
+		{nil, false},
+	} {
+		assert.Equal(t, test.transient, repository.IsTransient(test.err), "%v", test.err)
+	}
+}
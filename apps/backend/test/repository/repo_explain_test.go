@@ -0,0 +1,129 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+
+	"github.com/theCompanyDream/id-trials/apps/backend/models"
+	"github.com/theCompanyDream/id-trials/apps/backend/repository"
+	"github.com/theCompanyDream/id-trials/apps/backend/utils"
+)
+
+// analyzedPlan stands in for the output of EXPLAIN (ANALYZE, BUFFERS, FORMAT JSON).
+const analyzedPlan = `[{"Plan": {"Node Type": "ModifyTable", "Relation Name": "users_snowflake",
+	"Shared Hit Blocks": 6, "Shared Read Blocks": 1,
+	"Plans": [{"Node Type": "Index Scan", "Relation Name": "users_snowflake"}]},
+	"Planning Time": 0.05, "Execution Time": 0.2}]`
+
+// explainConn answers EXPLAIN, which SQLite cannot, by running the explained
+// statement and returning analyzedPlan, and logs every statement it runs.
+type explainConn struct {
+	gorm.ConnPool
+	log *[]string
+}
+
+func (c explainConn) ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	*c.log = append(*c.log, query)
+	return c.ConnPool.ExecContext(ctx, query, args...)
+}
+
+func (c explainConn) QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error) {
+	*c.log = append(*c.log, query)
+	return c.ConnPool.QueryContext(ctx, query, args...)
+}
+
+func (c explainConn) QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row {
+	*c.log = append(*c.log, query)
+	if statement, ok := strings.CutPrefix(query, "EXPLAIN (ANALYZE, BUFFERS, FORMAT JSON) "); ok {
+		if rows, err := c.ConnPool.QueryContext(ctx, statement, args...); err == nil {
+			rows.Close()
+		}
+		return c.ConnPool.QueryRowContext(ctx, "SELECT ?", analyzedPlan)
+	}
+	return c.ConnPool.QueryRowContext(ctx, query, args...)
+}
+
+func (c explainConn) BeginTx(ctx context.Context, opts *sql.TxOptions) (gorm.ConnPool, error) {
+	tx, err := c.ConnPool.(*sql.DB).BeginTx(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	return &explainTx{explainConn{tx, c.log}, tx}, nil
+}
+
+type explainTx struct {
+	explainConn
+	tx *sql.Tx
+}
+
+func (t *explainTx) Commit() error   { return t.tx.Commit() }
+func (t *explainTx) Rollback() error { return t.tx.Rollback() }
+
+func explainDB(t *testing.T) (*gorm.DB, *[]string) {
+	sqlDB, err := sql.Open("sqlite3", ":memory:")
+	require.NoError(t, err)
+	sqlDB.SetMaxOpenConns(1)
+	t.Cleanup(func() { sqlDB.Close() })
+
+	var log []string
+	db, err := gorm.Open(sqlite.Dialector{Conn: explainConn{sqlDB, &log}}, &gorm.Config{Logger: logger.Discard})
+	require.NoError(t, err)
+	require.NoError(t, db.AutoMigrate(&models.UserSnowflake{}))
+	require.NoError(t, db.Use(repository.ExplainPlugin{}))
+	return db, &log
+}
+
+// TestExplainUpdate_AnalyzesBeforeWriting checks that an explained update is
+// run under EXPLAIN ANALYZE in a rolled back savepoint or transaction before
+// the real write, so its plan carries buffer counts and the write happens once.
+func TestExplainUpdate_AnalyzesBeforeWriting(t *testing.T) {
+	for name, session := range map[string]*gorm.Session{
+		"in the update's transaction": {},
+		"without a transaction":       {SkipDefaultTransaction: true},
+	} {
+		t.Run(name, func(t *testing.T) {
+			db, log := explainDB(t)
+			created, err := repository.NewGormSnowRepository(db).CreateUser(models.UserSnowflake{
+				UserBase: &models.UserBase{UserName: "analyzed", FirstName: "Plan", LastName: "Runner", Email: "plan@example.com"},
+			})
+			require.NoError(t, err)
+
+			collector := repository.NewQueryPlanCollector()
+			ctx := repository.WithQueryPlans(context.Background(), collector)
+			*log = nil
+			created.Version = 1
+			updated, err := repository.NewGormSnowRepository(db.Session(session).WithContext(ctx)).UpdateUser(*created)
+			require.NoError(t, err)
+			assert.Equal(t, int64(2), updated.Version, "the analyzed run was rolled back")
+
+			plans := collector.Plans()
+			require.Len(t, plans, 1)
+			assert.Equal(t, "update", plans[0].Operation)
+			assert.True(t, strings.HasPrefix(plans[0].Query, "UPDATE"), plans[0].Query)
+			summary, err := utils.SummarizePlan(plans[0].Plan)
+			require.NoError(t, err)
+			assert.Equal(t, int64(6), summary.SharedHitBlocks)
+			assert.Equal(t, int64(1), summary.SharedReadBlocks)
+			assert.Positive(t, collector.Overhead())
+
+			explained, written := -1, -1
+			for i, statement := range *log {
+				if strings.HasPrefix(statement, "EXPLAIN (ANALYZE, BUFFERS, FORMAT JSON) UPDATE") {
+					explained = i
+				} else if strings.HasPrefix(statement, "UPDATE") {
+					written = i
+				}
+			}
+			require.NotEqual(t, -1, explained, "the update is analyzed: %v", *log)
+			assert.Less(t, explained, written, "the update is analyzed before it is written")
+		})
+	}
+}
@@ -0,0 +1,32 @@
+package repository
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/theCompanyDream/id-trials/apps/backend/models"
+	"github.com/theCompanyDream/id-trials/apps/backend/repository"
+	"github.com/theCompanyDream/id-trials/apps/backend/test/setup"
+)
+
+// TestExportUlidUsers streams more rows than one keyset page holds and checks
+// every user arrives once, in ID order.
+func TestExportUlidUsers(t *testing.T) {
+	db := setup.NewPostgresMockDB()
+	ulidRepository := repository.NewGormUlidRepository(db)
+
+	_, err := ulidRepository.CreateUsers(batchOfUlidUsers(2500), true)
+	require.NoError(t, err)
+
+	var ids []string
+	err = ulidRepository.ExportUsers("", func(user *models.UserDTO) error {
+		ids = append(ids, user.ID)
+		return nil
+	})
+	require.NoError(t, err)
+	require.Len(t, ids, 2500)
+	for i := 1; i < len(ids); i++ {
+		require.Less(t, ids[i-1], ids[i], "export should walk IDs in order without repeats")
+	}
+
+}
@@ -0,0 +1,207 @@
+package repository
+
+import (
+	"io/fs"
+	"regexp"
+	"slices"
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+
+	"github.com/theCompanyDream/id-trials/apps/backend/models"
+	"github.com/theCompanyDream/id-trials/apps/backend/repository"
+)
+
+func migrationFS() fstest.MapFS {
+	return fstest.MapFS{
+		"0001_create_things.up.sql":   {Data: []byte("CREATE TABLE things (id INTEGER PRIMARY KEY, name TEXT NOT NULL);")},
+		"0001_create_things.down.sql": {Data: []byte("DROP TABLE things;")},
+		"0002_index_things.up.sql":    {Data: []byte("CREATE INDEX idx_things_name ON things (name);\nALTER TABLE things ADD COLUMN size INTEGER;")},
+		"0002_index_things.down.sql":  {Data: []byte("DROP INDEX idx_things_name;\nALTER TABLE things DROP COLUMN size;")},
+		"README.md":                   {Data: []byte("not a migration")},
+	}
+}
+
+func emptyDB(t *testing.T) *gorm.DB {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	require.NoError(t, err)
+	return db
+}
+
+func TestMigrator_UpDownStatus(t *testing.T) {
+	db := emptyDB(t)
+	migrator, err := repository.NewMigrator(db, migrationFS())
+	require.NoError(t, err)
+	assert.ErrorIs(t, migrator.Check(), repository.ErrSchemaMismatch, "a fresh database is behind")
+
+	applied, err := migrator.Up(1)
+	require.NoError(t, err)
+	require.Len(t, applied, 1)
+	assert.Equal(t, "create_things", applied[0].Name)
+	assert.ErrorContains(t, migrator.Check(), "0002_index_things is pending")
+
+	applied, err = migrator.Up(0)
+	require.NoError(t, err)
+	require.Len(t, applied, 1)
+	require.NoError(t, migrator.Check())
+	assert.True(t, db.Migrator().HasColumn("things", "size"))
+
+	states, err := migrator.Status()
+	require.NoError(t, err)
+	require.Len(t, states, 2)
+	assert.NotNil(t, states[1].AppliedAt)
+
+	rolledBack, err := migrator.Down(1)
+	require.NoError(t, err)
+	require.Len(t, rolledBack, 1)
+	assert.Equal(t, int64(2), rolledBack[0].Version)
+	assert.False(t, db.Migrator().HasColumn("things", "size"))
+
+	_, err = migrator.Down(0)
+	require.NoError(t, err)
+	assert.False(t, db.Migrator().HasTable("things"))
+}
+
+func TestMigrator_FailedMigrationIsNotRecorded(t *testing.T) {
+	db := emptyDB(t)
+	files := migrationFS()
+	files["0003_broken.up.sql"] = &fstest.MapFile{Data: []byte("ALTER TABLE missing ADD COLUMN x INTEGER;")}
+	files["0003_broken.down.sql"] = &fstest.MapFile{Data: []byte("SELECT 1;")}
+	migrator, err := repository.NewMigrator(db, files)
+	require.NoError(t, err)
+
+	applied, err := migrator.Up(0)
+	assert.ErrorContains(t, err, "migration 0003_broken")
+	assert.Len(t, applied, 2)
+	assert.ErrorContains(t, migrator.Check(), "0003_broken is pending")
+}
+
+func TestMigrator_DetectsDrift(t *testing.T) {
+	db := emptyDB(t)
+	migrator, err := repository.NewMigrator(db, migrationFS())
+	require.NoError(t, err)
+	_, err = migrator.Up(0)
+	require.NoError(t, err)
+
+	edited := migrationFS()
+	edited["0002_index_things.up.sql"] = &fstest.MapFile{Data: []byte("CREATE INDEX idx_things_name ON things (name);")}
+	migrator, err = repository.NewMigrator(db, edited)
+	require.NoError(t, err)
+	assert.ErrorContains(t, migrator.Check(), "0002_index_things changed after it was applied")
+
+	older := migrationFS()
+	delete(older, "0002_index_things.up.sql")
+	delete(older, "0002_index_things.down.sql")
+	migrator, err = repository.NewMigrator(db, older)
+	require.NoError(t, err)
+	assert.ErrorContains(t, migrator.Check(), "unknown to this build")
+}
+
+func TestNewMigrator_RequiresBothDirections(t *testing.T) {
+	files := migrationFS()
+	delete(files, "0002_index_things.down.sql")
+	_, err := repository.NewMigrator(emptyDB(t), files)
+	assert.ErrorContains(t, err, "needs both an up and a down file")
+}
+
+// TestMigrations_CoverModels checks that the Postgres migrations create every
+// column and index the models declare, so the two cannot drift apart.
+func TestMigrations_CoverModels(t *testing.T) {
+	var up strings.Builder
+	files := repository.Migrations()
+	names, err := fs.Glob(files, "*.up.sql")
+	require.NoError(t, err)
+	require.NotEmpty(t, names)
+	for _, name := range names {
+		sql, err := fs.ReadFile(files, name)
+		require.NoError(t, err)
+		up.Write(sql)
+	}
+
+	migrator, err := repository.NewMigrator(emptyDB(t), files)
+	require.NoError(t, err)
+	_, err = migrator.Status()
+	require.NoError(t, err, "the embedded migrations pair up")
+
+	db := emptyDB(t)
+	tables := []interface{}{
+		&models.UserUlid{}, &models.UserKSUID{}, &models.UserUUID{},
+		&models.UserCUID{}, &models.UserNanoID{}, &models.UserSnowflake{},
+		&models.OrderUlid{}, &models.OrderKSUID{}, &models.OrderUUID{},
+		&models.OrderCUID{}, &models.OrderNanoID{}, &models.OrderSnowflake{},
+	}
+	for _, spec := range repository.PartitionSpecs {
+		tables = append(tables, spec.Model)
+	}
+	tables = append(tables, repository.MetricModels()...)
+
+	for _, model := range tables {
+		stmt := &gorm.Statement{DB: db}
+		require.NoError(t, stmt.Parse(model))
+		table := stmt.Schema.Table
+
+		create := regexp.MustCompile(`(?s)CREATE TABLE IF NOT EXISTS ` + table + ` \((.*?)\n\)[^;]*;`).FindStringSubmatch(up.String())
+		require.NotNil(t, create, "no CREATE TABLE for %s", table)
+		for _, field := range stmt.Schema.Fields {
+			if field.DBName == "" {
+				continue
+			}
+			// Columns come from the CREATE TABLE or a later ADD COLUMN
+			created := regexp.MustCompile(`(?m)^\s+` + field.DBName + `\s`).MatchString(create[1])
+			added := strings.Contains(up.String(), "ALTER TABLE "+table+" ADD COLUMN IF NOT EXISTS "+field.DBName+" ")
+			assert.True(t, created || added, "%s.%s", table, field.DBName)
+		}
+		for _, index := range stmt.Schema.ParseIndexes() {
+			assert.Contains(t, up.String(), "INDEX IF NOT EXISTS "+index.Name+" ON "+table, "%s index %s", table, index.Name)
+		}
+	}
+}
+
+// TestMigrations_AdoptAutoMigrateTables checks that every column added to the
+// tables of the AutoMigrate releases since then is also added to an adopted
+// table, which CREATE TABLE IF NOT EXISTS leaves as it was.
+func TestMigrations_AdoptAutoMigrateTables(t *testing.T) {
+	var up strings.Builder
+	files := repository.Migrations()
+	names, err := fs.Glob(files, "*.up.sql")
+	require.NoError(t, err)
+	for _, name := range names {
+		sql, err := fs.ReadFile(files, name)
+		require.NoError(t, err)
+		up.Write(sql)
+	}
+
+	// The columns AutoMigrate created in the last release before migrations
+	users := []string{"id", "user_name", "first_name", "last_name", "email", "department"}
+	released := map[interface{}][]string{
+		&models.UserUlid{}:      users,
+		&models.UserKSUID{}:     users,
+		&models.UserUUID{}:      users,
+		&models.UserCUID{}:      users,
+		&models.UserNanoID{}:    users,
+		&models.UserSnowflake{}: users,
+		&models.RouteMetric{}: {
+			"id", "route_path", "http_method", "id_type", "total_duration", "db_query_duration",
+			"handler_duration", "status_code", "response_size", "is_error", "error_message",
+			"request_id", "timestamp", "user_agent", "ip_address",
+		},
+	}
+
+	db := emptyDB(t)
+	for model, columns := range released {
+		stmt := &gorm.Statement{DB: db}
+		require.NoError(t, stmt.Parse(model))
+		for _, field := range stmt.Schema.Fields {
+			if field.DBName == "" || slices.Contains(columns, field.DBName) {
+				continue
+			}
+			added := strings.Contains(up.String(), "ALTER TABLE "+stmt.Schema.Table+" ADD COLUMN IF NOT EXISTS "+field.DBName+" ")
+			assert.True(t, added, "%s.%s", stmt.Schema.Table, field.DBName)
+		}
+	}
+}
@@ -118,6 +118,7 @@ func TestUpdateNanoId(t *testing.T) {
 	created.FirstName = "Mob"
 	updated, err := nanoIdRepository.UpdateUser(*created)
 	require.NoError(t, err, "failed to update user")
+	created.Version = updated.Version // later updates must carry the current version
 	assert.Equal(t, created.FirstName, updated.FirstName, "first name should be updated")
 	assert.Equal(t, created.UserName, user.UserName, "user name should match")
 	assert.Equal(t, created.FirstName, user.FirstName, "first should match")
@@ -128,6 +129,7 @@ func TestUpdateNanoId(t *testing.T) {
 	created.LastName = "Psycho"
 	updated, err = nanoIdRepository.UpdateUser(*created)
 	require.NoError(t, err, "failed to update user")
+	created.Version = updated.Version
 	assert.Equal(t, created.FirstName, updated.FirstName, "first name should be updated")
 	assert.Equal(t, created.UserName, user.UserName, "user name should match")
 	assert.Equal(t, created.FirstName, user.FirstName, "first should match")
@@ -138,6 +140,7 @@ func TestUpdateNanoId(t *testing.T) {
 	created.Email = "mobpsycho@example.com"
 	updated, err = nanoIdRepository.UpdateUser(*created)
 	require.NoError(t, err, "failed to update user")
+	created.Version = updated.Version
 	assert.Equal(t, created.FirstName, updated.FirstName, "first name should be updated")
 	assert.Equal(t, created.UserName, user.UserName, "user name should match")
 	assert.Equal(t, created.FirstName, user.FirstName, "first should match")
@@ -149,6 +152,7 @@ func TestUpdateNanoId(t *testing.T) {
 	created.Department = &deparment
 	updated, err = nanoIdRepository.UpdateUser(*created)
 	require.NoError(t, err, "failed to update user")
+	created.Version = updated.Version
 	assert.Equal(t, created.FirstName, updated.FirstName, "first name should be updated")
 	assert.Equal(t, created.UserName, user.UserName, "user name should match")
 	assert.Equal(t, created.FirstName, user.FirstName, "first should match")
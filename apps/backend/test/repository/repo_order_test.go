@@ -0,0 +1,114 @@
+package repository
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/theCompanyDream/id-trials/apps/backend/models"
+	"github.com/theCompanyDream/id-trials/apps/backend/repository"
+	"github.com/theCompanyDream/id-trials/apps/backend/test/setup"
+)
+
+// TestCreateAndListUlidOrders tests creating orders and listing them through the users join.
+func TestCreateAndListUlidOrders(t *testing.T) {
+	db := setup.NewPostgresMockDB()
+
+	department := "Engineering"
+	user, err := repository.NewGormUlidRepository(db).CreateUser(models.UserUlid{
+		UserBase: &models.UserBase{
+			UserName:   "orderuser",
+			FirstName:  "Order",
+			LastName:   "User",
+			Email:      "order@example.com",
+			Department: &department,
+		},
+	})
+	require.NoError(t, err, "failed to create parent user")
+
+	orderRepository := repository.NewGormUlidOrderRepository(db)
+
+	var created []models.OrderUlid
+	for i := 0; i < 3; i++ {
+		order, err := orderRepository.CreateOrder(models.OrderUlid{
+			UserID: user.ID,
+			OrderBase: &models.OrderBase{
+				Product:    fmt.Sprintf("Product %d", i),
+				TotalCents: int64(100 * (i + 1)),
+			},
+		})
+		require.NoError(t, err, "failed to create order %d", i)
+		require.NotEmpty(t, order.ID, "order ID should not be empty after creation")
+		assert.Equal(t, 1, order.Quantity, "quantity should default to 1")
+		assert.Equal(t, "pending", order.Status, "status should default to pending")
+		created = append(created, *order)
+	}
+
+	page, err := orderRepository.GetOrdersByUser(user.ID, 1, 10)
+	require.NoError(t, err, "failed to list orders")
+	require.Len(t, page.Orders, len(created))
+	assert.Equal(t, 1, *page.PageCount)
+	for idx, order := range page.Orders {
+		assert.Equal(t, created[idx].ID, order.ID, "order ID should match")
+		assert.Equal(t, user.ID, order.UserID, "parent ID should match")
+		assert.Equal(t, user.UserName, order.UserName, "user name should come from the join")
+	}
+
+	// A user without orders has an empty page, an unknown user none at all
+	other, err := repository.NewGormUlidRepository(db).CreateUser(models.UserUlid{
+		UserBase: &models.UserBase{UserName: "noorders", FirstName: "No", LastName: "Orders", Email: "none@example.com"},
+	})
+	require.NoError(t, err)
+	empty, err := orderRepository.GetOrdersByUser(other.ID, 1, 10)
+	require.NoError(t, err)
+	assert.Empty(t, empty.Orders)
+
+	_, err = orderRepository.GetOrdersByUser("missing", 1, 10)
+	assert.ErrorIs(t, err, repository.ErrNotFound)
+}
+
+// TestUpdateAndDeleteSnowOrder tests the bigint keyed orders table.
+func TestUpdateAndDeleteSnowOrder(t *testing.T) {
+	db := setup.NewPostgresMockDB()
+
+	user, err := repository.NewGormSnowRepository(db).CreateUser(models.UserSnowflake{
+		UserBase: &models.UserBase{
+			UserName:  "snowuser",
+			FirstName: "Snow",
+			LastName:  "User",
+			Email:     "snow@example.com",
+		},
+	})
+	require.NoError(t, err, "failed to create parent user")
+
+	orderRepository := repository.NewGormSnowOrderRepository(db)
+	order, err := orderRepository.CreateOrder(models.OrderSnowflake{
+		UserID:    user.ID,
+		OrderBase: &models.OrderBase{Product: "Sled", TotalCents: 4200},
+	})
+	require.NoError(t, err, "failed to create order")
+	require.NotZero(t, order.ID)
+
+	id := strconv.FormatInt(order.ID, 10)
+	updated, err := orderRepository.UpdateOrder(models.OrderSnowflake{
+		ID:        order.ID,
+		OrderBase: &models.OrderBase{Status: "shipped"},
+	})
+	require.NoError(t, err, "failed to update order")
+	assert.Equal(t, "shipped", updated.Status)
+	assert.Equal(t, "Sled", updated.Product, "product should be unchanged")
+
+	page, err := orderRepository.GetOrdersByUser(strconv.FormatInt(user.ID, 10), 1, 10)
+	require.NoError(t, err, "failed to list orders")
+	require.Len(t, page.Orders, 1)
+	assert.Equal(t, id, page.Orders[0].ID)
+
+	err = orderRepository.DeleteOrder(id)
+	require.NoError(t, err, "failed to delete order")
+
+	_, err = orderRepository.GetOrder(id)
+	require.Error(t, err, "expected error when fetching deleted order")
+}
@@ -0,0 +1,62 @@
+package repository
+
+import (
+	"testing"
+	"time"
+
+	"github.com/bwmarrin/snowflake"
+	"github.com/oklog/ulid/v2"
+	"github.com/segmentio/ksuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/theCompanyDream/id-trials/apps/backend/config"
+	"github.com/theCompanyDream/id-trials/apps/backend/repository"
+)
+
+// TestPartitionBoundsContainGeneratedIds checks that an ID generated at t sorts
+// inside the [Bound(start of month), Bound(start of next month)) range.
+func TestPartitionBoundsContainGeneratedIds(t *testing.T) {
+	now := time.Now().UTC()
+	start := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+	end := start.AddDate(0, 1, 0)
+
+	node, err := snowflake.NewNode(1)
+	require.NoError(t, err)
+	ksuidId, err := ksuid.NewRandomWithTime(now)
+	require.NoError(t, err)
+
+	generated := map[string]interface{}{
+		"ULID":      ulid.MustNew(ulid.Timestamp(now), ulid.DefaultEntropy()).String(),
+		"KSUID":     ksuidId.String(),
+		"Snowflake": node.Generate().Int64(),
+	}
+
+	for _, spec := range repository.PartitionSpecs {
+		lower, err := spec.Bound(start)
+		require.NoError(t, err)
+		upper, err := spec.Bound(end)
+		require.NoError(t, err)
+
+		switch id := generated[spec.IDType].(type) {
+		case string:
+			assert.LessOrEqual(t, lower.(string), id, spec.IDType)
+			assert.Less(t, id, upper.(string), spec.IDType)
+		case int64:
+			assert.LessOrEqual(t, lower.(int64), id, spec.IDType)
+			assert.Less(t, id, upper.(int64), spec.IDType)
+		}
+	}
+}
+
+func TestStorageModeFollowsPartitionedStorage(t *testing.T) {
+	cfg := config.Default()
+	cfg.Storage.Partitioned = true
+	repository.Configure(cfg)
+	t.Cleanup(func() { repository.Configure(config.Default()) })
+	assert.Equal(t, "partitioned", repository.StorageMode("ULID"))
+	assert.Equal(t, "heap", repository.StorageMode("UUID"))
+
+	repository.Configure(config.Default())
+	assert.Equal(t, "heap", repository.StorageMode("ULID"))
+}
@@ -0,0 +1,86 @@
+package repository
+
+import (
+	"io/fs"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+
+	"github.com/theCompanyDream/id-trials/apps/backend/config"
+	"github.com/theCompanyDream/id-trials/apps/backend/models"
+	"github.com/theCompanyDream/id-trials/apps/backend/repository"
+	"github.com/theCompanyDream/id-trials/apps/backend/test/setup"
+)
+
+// searchSQL renders the list query ApplySearch builds without running it.
+func searchSQL(search string) (string, []interface{}) {
+	db := setup.NewPostgresMockDB()
+	stmt := repository.ApplySearch(db.Session(&gorm.Session{DryRun: true}).Model(&models.UserUlid{}), search).
+		Find(&[]models.UserUlid{}).Statement
+	return stmt.SQL.String(), stmt.Vars
+}
+
+// useSearchMode configures the repositories with mode until the test ends.
+func useSearchMode(t *testing.T, mode string) {
+	cfg := config.Default()
+	cfg.Search.Mode = mode
+	repository.Configure(cfg)
+	t.Cleanup(func() { repository.Configure(config.Default()) })
+}
+
+func TestApplySearchModes(t *testing.T) {
+	useSearchMode(t, repository.SearchModeILike)
+	sql, vars := searchSQL("Jane")
+	assert.Contains(t, sql, "lower(user_name) LIKE ?")
+	assert.NotContains(t, sql, "ILIKE", "the baseline must not match the trigram indexes")
+	assert.Equal(t, "%jane%", vars[0])
+
+	useSearchMode(t, repository.SearchModeTrigram)
+	sql, _ = searchSQL("jane")
+	assert.Contains(t, sql, "user_name ILIKE ?")
+
+	useSearchMode(t, repository.SearchModeFullText)
+	sql, vars = searchSQL("Jane O'Neil!")
+	assert.Contains(t, sql, "@@ to_tsquery('simple', ?)")
+	assert.Equal(t, []interface{}{"Jane:* & O:* & Neil:*"}, vars)
+
+	// Nothing left to match on falls back to ILIKE
+	sql, _ = searchSQL("!!")
+	assert.Contains(t, sql, "ILIKE")
+}
+
+func TestApplySearchEmptyTerm(t *testing.T) {
+	useSearchMode(t, repository.SearchModeFullText)
+	sql, _ := searchSQL("")
+	assert.NotContains(t, sql, "WHERE")
+}
+
+// TestSearchIndexesMatchQueries checks that the full-text query uses the
+// expression migration 0008 indexes, and that every users table has the
+// indexes of every mode.
+func TestSearchIndexesMatchQueries(t *testing.T) {
+	migration, err := fs.ReadFile(repository.Migrations(), "0008_create_search_indexes.up.sql")
+	require.NoError(t, err)
+
+	useSearchMode(t, repository.SearchModeFullText)
+	sql, _ := searchSQL("jane")
+	document := sql[strings.Index(sql, "to_tsvector"):strings.Index(sql, " @@")]
+	assert.Contains(t, string(migration), "USING gin ("+document+");")
+
+	tables := []string{
+		models.UserUlid{}.TableName(), models.UserUUID{}.TableName(), models.UserKSUID{}.TableName(),
+		models.UserCUID{}.TableName(), models.UserNanoID{}.TableName(), models.UserSnowflake{}.TableName(),
+	}
+	for _, spec := range repository.PartitionSpecs {
+		tables = append(tables, spec.Table)
+	}
+	for _, table := range tables {
+		assert.Contains(t, string(migration), "idx_"+table+"_search_tsv ON "+table+" ")
+		for _, column := range []string{"user_name", "first_name", "last_name", "email"} {
+			assert.Contains(t, string(migration), "ON "+table+" USING gin ("+column+" gin_trgm_ops)")
+		}
+	}
+}
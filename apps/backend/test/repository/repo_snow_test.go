@@ -120,6 +120,7 @@ func TestUpdateSnowFlake(t *testing.T) {
 	created.FirstName = "Peter"
 	updated, err := snowRepository.UpdateUser(*created)
 	require.NoError(t, err, "failed to update user")
+	created.Version = updated.Version // later updates must carry the current version
 	assert.Equal(t, created.FirstName, updated.FirstName, "first name should be updated")
 	assert.Equal(t, created.UserName, user.UserName, "user name should match")
 	assert.Equal(t, created.FirstName, user.FirstName, "first should match")
@@ -130,6 +131,7 @@ func TestUpdateSnowFlake(t *testing.T) {
 	created.LastName = "Parker"
 	updated, err = snowRepository.UpdateUser(*created)
 	require.NoError(t, err, "failed to update user")
+	created.Version = updated.Version
 	assert.Equal(t, created.FirstName, updated.FirstName, "first name should be updated")
 	assert.Equal(t, created.UserName, user.UserName, "user name should match")
 	assert.Equal(t, created.FirstName, user.FirstName, "first should match")
@@ -140,6 +142,7 @@ func TestUpdateSnowFlake(t *testing.T) {
 	created.Email = "peterparker@dailbugle.com"
 	updated, err = snowRepository.UpdateUser(*created)
 	require.NoError(t, err, "failed to update user")
+	created.Version = updated.Version
 	assert.Equal(t, created.FirstName, updated.FirstName, "first name should be updated")
 	assert.Equal(t, created.UserName, user.UserName, "user name should match")
 	assert.Equal(t, created.FirstName, user.FirstName, "first should match")
@@ -151,6 +154,7 @@ func TestUpdateSnowFlake(t *testing.T) {
 	created.Department = &deparment
 	updated, err = snowRepository.UpdateUser(*created)
 	require.NoError(t, err, "failed to update user")
+	created.Version = updated.Version
 	assert.Equal(t, created.FirstName, updated.FirstName, "first name should be updated")
 	assert.Equal(t, created.UserName, user.UserName, "user name should match")
 	assert.Equal(t, created.FirstName, user.FirstName, "first should match")
@@ -118,6 +118,7 @@ func TestUpdateUlidUser(t *testing.T) {
 	created.FirstName = "Virgil"
 	updated, err := ulidRepository.UpdateUser(*created)
 	require.NoError(t, err, "failed to update user")
+	created.Version = updated.Version // later updates must carry the current version
 	assert.Equal(t, created.FirstName, updated.FirstName, "first name should be updated")
 	assert.Equal(t, created.UserName, user.UserName, "user name should match")
 	assert.Equal(t, created.FirstName, user.FirstName, "first should match")
@@ -128,6 +129,7 @@ func TestUpdateUlidUser(t *testing.T) {
 	created.LastName = "Hawkins"
 	updated, err = ulidRepository.UpdateUser(*created)
 	require.NoError(t, err, "failed to update user")
+	created.Version = updated.Version
 	assert.Equal(t, created.FirstName, updated.FirstName, "first name should be updated")
 	assert.Equal(t, created.UserName, user.UserName, "user name should match")
 	assert.Equal(t, created.FirstName, user.FirstName, "first should match")
@@ -138,6 +140,7 @@ func TestUpdateUlidUser(t *testing.T) {
 	created.Email = "virgilhawkins@staticshock.com"
 	updated, err = ulidRepository.UpdateUser(*created)
 	require.NoError(t, err, "failed to update user")
+	created.Version = updated.Version
 	assert.Equal(t, created.FirstName, updated.FirstName, "first name should be updated")
 	assert.Equal(t, created.UserName, user.UserName, "user name should match")
 	assert.Equal(t, created.FirstName, user.FirstName, "first should match")
@@ -149,6 +152,7 @@ func TestUpdateUlidUser(t *testing.T) {
 	created.Department = &deparment
 	updated, err = ulidRepository.UpdateUser(*created)
 	require.NoError(t, err, "failed to update user")
+	created.Version = updated.Version
 	assert.Equal(t, created.FirstName, updated.FirstName, "first name should be updated")
 	assert.Equal(t, created.UserName, user.UserName, "user name should match")
 	assert.Equal(t, created.FirstName, user.FirstName, "first should match")
@@ -186,3 +190,15 @@ func TestDeleteUlidUser(t *testing.T) {
 	_, err = ulidRepository.GetUser(created.ID)
 	require.Error(t, err, "expected error when fetching deleted user")
 }
+
+func TestMissingUlidUserIsNotFound(t *testing.T) {
+	db := setup.NewPostgresMockDB()
+	ulidRepository := repository.NewGormUlidRepository(db)
+
+	_, err := ulidRepository.GetUser("01HZX3K4Q2M8V6T9R5N7B1C0DE")
+	assert.ErrorIs(t, err, repository.ErrNotFound)
+
+	// Deleting a row that does not exist is reported instead of silently succeeding
+	err = ulidRepository.DeleteUser("01HZX3K4Q2M8V6T9R5N7B1C0DE")
+	assert.ErrorIs(t, err, repository.ErrNotFound)
+}
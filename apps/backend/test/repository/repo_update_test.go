@@ -0,0 +1,58 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+
+	"github.com/theCompanyDream/id-trials/apps/backend/models"
+	"github.com/theCompanyDream/id-trials/apps/backend/repository"
+	"github.com/theCompanyDream/id-trials/apps/backend/test/setup"
+)
+
+// sqlRecorder records every statement a session runs.
+type sqlRecorder struct {
+	logger.Interface
+	statements []string
+}
+
+func (r *sqlRecorder) Trace(_ context.Context, _ time.Time, fc func() (string, int64), _ error) {
+	sql, _ := fc()
+	r.statements = append(r.statements, sql)
+}
+
+// TestUpdateUser_ReturnsWrittenRow checks that UpdateUser returns the row its
+// own UPDATE wrote, rather than re-reading it where another write could land
+// in between.
+func TestUpdateUser_ReturnsWrittenRow(t *testing.T) {
+	db := setup.NewPostgresMockDB()
+	created, err := repository.NewGormSnowRepository(db).CreateUser(models.UserSnowflake{
+		UserBase: &models.UserBase{UserName: "returning", FirstName: "Row", LastName: "Writer", Email: "row@example.com"},
+	})
+	require.NoError(t, err)
+
+	recorder := &sqlRecorder{Interface: logger.Discard}
+	repo := repository.NewGormSnowRepository(db.Session(&gorm.Session{Logger: recorder}))
+
+	created.FirstName = "Returned"
+	created.Version = 1
+	updated, err := repo.UpdateUser(*created)
+	require.NoError(t, err)
+	assert.Equal(t, created.ID, updated.ID)
+	assert.Equal(t, "Returned", updated.FirstName)
+	assert.Equal(t, "returning", updated.UserName)
+	assert.Equal(t, int64(2), updated.Version)
+
+	require.Len(t, recorder.statements, 1, "the update is the only statement")
+	assert.Contains(t, recorder.statements[0], "UPDATE")
+	assert.Contains(t, recorder.statements[0], "RETURNING")
+
+	// A stale version still fails without returning anything
+	_, err = repo.UpdateUser(*created)
+	assert.ErrorIs(t, err, repository.PreconditionFailed("user", ""))
+}
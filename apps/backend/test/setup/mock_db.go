@@ -1,10 +1,17 @@
 package setup
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io/fs"
 	"log"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/theCompanyDream/id-trials/apps/backend/models"
+	"github.com/theCompanyDream/id-trials/apps/backend/repository"
 	"gorm.io/driver/sqlite"
 	"gorm.io/gorm"
 )
@@ -26,15 +33,7 @@ func NewPostgresMockDB() *gorm.DB {
 	}
 
 	// Auto-migrate with explicit table names
-	models := []interface{}{
-		&models.UserUlid{},
-		&models.UserCUID{},
-		&models.UserUUID{},
-		&models.UserKSUID{},
-		&models.UserSnowflake{},
-		&models.UserNanoID{},
-		&models.RouteMetric{},
-	}
+	models := testModels()
 
 	for _, model := range models {
 		if err := db.AutoMigrate(model); err != nil {
@@ -49,20 +48,79 @@ func NewPostgresMockDB() *gorm.DB {
 	return db
 }
 
+// testModels are the tables of the test database. The migrations are written
+// for Postgres, so SQLite gets its tables from the models instead.
+func testModels() []interface{} {
+	return []interface{}{
+		&models.UserUlid{},
+		&models.UserCUID{},
+		&models.UserUUID{},
+		&models.UserKSUID{},
+		&models.UserSnowflake{},
+		&models.UserNanoID{},
+		&models.OrderUlid{},
+		&models.OrderCUID{},
+		&models.OrderUUID{},
+		&models.OrderKSUID{},
+		&models.OrderSnowflake{},
+		&models.OrderNanoID{},
+		&models.RouteMetric{},
+		&models.WriteBenchmark{},
+		&models.GenerationRun{},
+		&models.GenerationCheckpoint{},
+		&models.QueryPlan{},
+		&models.PoolStat{},
+	}
+}
+
+// MarkMigrated records every embedded migration as applied, so the schema
+// check passes on the test database.
+func MarkMigrated(t *testing.T, db *gorm.DB) {
+	t.Helper()
+
+	files := repository.Migrations()
+	names, err := fs.Glob(files, "*.up.sql")
+	if err != nil {
+		t.Fatalf("failed to list migrations: %v", err)
+	}
+	if err := db.AutoMigrate(&repository.AppliedMigration{}); err != nil {
+		t.Fatalf("failed to create schema_migrations: %v", err)
+	}
+
+	for _, name := range names {
+		up, err := fs.ReadFile(files, name)
+		if err != nil {
+			t.Fatalf("failed to read %s: %v", name, err)
+		}
+		var version int64
+		var migration string
+		if _, err := fmt.Sscanf(strings.Replace(name, "_", " ", 1), "%d %s", &version, &migration); err != nil {
+			t.Fatalf("failed to parse %s: %v", name, err)
+		}
+		sum := sha256.Sum256(up)
+		if err := db.Create(&repository.AppliedMigration{
+			Version:   version,
+			Name:      strings.TrimSuffix(migration, ".up.sql"),
+			Checksum:  hex.EncodeToString(sum[:]),
+			AppliedAt: time.Now(),
+		}).Error; err != nil {
+			t.Fatalf("failed to record %s: %v", name, err)
+		}
+	}
+}
+
+// CleanupDB empties every table of the test database.
 func CleanupDB(t *testing.T, db *gorm.DB) {
 	t.Helper()
 
-	tables := []string{
-		"route_metrics",
-		"user_ulids",
-		"user_cuids",
-		"user_uuids",
-		"user_ksuids",
-		"user_snowflakes",
-		"user_nanoids",
+	tables, err := repository.TableNames(db, testModels()...)
+	if err != nil {
+		t.Fatalf("failed to resolve table names: %v", err)
 	}
 
 	for _, table := range tables {
-		db.Exec("DELETE FROM " + table)
+		if err := db.Exec("DELETE FROM " + table).Error; err != nil {
+			t.Fatalf("failed to clean up %s: %v", table, err)
+		}
 	}
 }
@@ -1,14 +1,22 @@
 package setup
 
 import (
+	"context"
+
 	"github.com/stretchr/testify/mock"
 	"github.com/theCompanyDream/id-trials/apps/backend/models"
+	"github.com/theCompanyDream/id-trials/apps/backend/repository"
 )
 
 type MockRepository[T any] struct {
 	mock.Mock
 }
 
+// WithContext returns the mock itself so expectations stay on one instance.
+func (m *MockRepository[T]) WithContext(ctx context.Context) repository.IRepository[T] {
+	return m
+}
+
 func (m *MockRepository[T]) GetUser(id string) (*T, error) {
 	args := m.Called(id)
 	if args.Get(0) == nil {
@@ -45,3 +53,86 @@ func (m *MockRepository[T]) DeleteUser(id string) error {
 	args := m.Called(id)
 	return args.Error(0)
 }
+
+func (m *MockRepository[T]) CreateUsers(users []T, atomic bool) ([]repository.BatchResult[T], error) {
+	args := m.Called(users, atomic)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]repository.BatchResult[T]), args.Error(1)
+}
+
+func (m *MockRepository[T]) GetUsersByID(ids []string) ([]T, error) {
+	args := m.Called(ids)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]T), args.Error(1)
+}
+
+func (m *MockRepository[T]) DeleteUsers(ids []string, atomic bool) ([]string, error) {
+	args := m.Called(ids, atomic)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]string), args.Error(1)
+}
+
+// ExportUsers emits the users the expectation returns, then its error.
+func (m *MockRepository[T]) ExportUsers(search string, emit func(*models.UserDTO) error) error {
+	args := m.Called(search)
+	if users, ok := args.Get(0).([]models.UserDTO); ok {
+		for i := range users {
+			if err := emit(&users[i]); err != nil {
+				return err
+			}
+		}
+	}
+	return args.Error(1)
+}
+
+type MockOrderRepository[T any] struct {
+	mock.Mock
+}
+
+// WithContext returns the mock itself so expectations stay on one instance.
+func (m *MockOrderRepository[T]) WithContext(ctx context.Context) repository.IOrderRepository[T] {
+	return m
+}
+
+func (m *MockOrderRepository[T]) GetOrder(id string) (*T, error) {
+	args := m.Called(id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*T), args.Error(1)
+}
+
+func (m *MockOrderRepository[T]) GetOrdersByUser(userId string, page, limit int) (*models.OrderPaging, error) {
+	args := m.Called(userId, page, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.OrderPaging), args.Error(1)
+}
+
+func (m *MockOrderRepository[T]) CreateOrder(order T) (*T, error) {
+	args := m.Called(order)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*T), args.Error(1)
+}
+
+func (m *MockOrderRepository[T]) UpdateOrder(order T) (*T, error) {
+	args := m.Called(order)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*T), args.Error(1)
+}
+
+func (m *MockOrderRepository[T]) DeleteOrder(id string) error {
+	args := m.Called(id)
+	return args.Error(0)
+}
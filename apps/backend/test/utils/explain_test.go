@@ -0,0 +1,57 @@
+package utils_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/theCompanyDream/id-trials/apps/backend/utils"
+)
+
+func TestSummarizePlan_IndexScan(t *testing.T) {
+	raw := `[{
+		"Plan": {
+			"Node Type": "Limit",
+			"Shared Hit Blocks": 4,
+			"Shared Read Blocks": 1,
+			"Plans": [{
+				"Node Type": "Index Scan",
+				"Shared Hit Blocks": 4,
+				"Shared Read Blocks": 1
+			}]
+		},
+		"Planning Time": 0.12,
+		"Execution Time": 0.05
+	}]`
+
+	summary, err := utils.SummarizePlan(raw)
+	require.NoError(t, err)
+	assert.Equal(t, "Limit", summary.RootNode)
+	assert.True(t, summary.UsesIndexScan)
+	assert.False(t, summary.UsesSeqScan)
+	assert.Equal(t, int64(4), summary.SharedHitBlocks)
+	assert.Equal(t, int64(1), summary.SharedReadBlocks)
+	assert.Equal(t, 0.12, summary.PlanningTime)
+	assert.Equal(t, 0.05, summary.ExecutionTime)
+}
+
+func TestSummarizePlan_SeqScan(t *testing.T) {
+	raw := `[{
+		"Plan": {
+			"Node Type": "Aggregate",
+			"Plans": [{"Node Type": "Seq Scan", "Shared Read Blocks": 120}]
+		},
+		"Execution Time": 12.5
+	}]`
+
+	summary, err := utils.SummarizePlan(raw)
+	require.NoError(t, err)
+	assert.Equal(t, "Aggregate", summary.RootNode)
+	assert.True(t, summary.UsesSeqScan)
+	assert.False(t, summary.UsesIndexScan)
+}
+
+func TestSummarizePlan_InvalidJSON(t *testing.T) {
+	_, err := utils.SummarizePlan("not a plan")
+	assert.Error(t, err)
+}
@@ -0,0 +1,35 @@
+package utils_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/theCompanyDream/id-trials/apps/backend/utils"
+)
+
+func TestValidID(t *testing.T) {
+	tests := []struct {
+		tag   string
+		id    string
+		valid bool
+	}{
+		{"ulid", "01HZX3K4Q2M8V6T9R5N7B1C0DE", true},
+		{"ulid", "01HZX3K4Q2M8V6T9R5N7B1C0D", false},
+		{"ksuid", "0ujtsYcgvSTl8PAuAdqWYSMnLOv", true},
+		{"ksuid", "not-a-ksuid", false},
+		{"uuid4", "9b2f6c1e-3f4a-4d2b-8c7e-1a2b3c4d5e6f", true},
+		{"uuid4", "01HZX3K4Q2M8V6T9R5N7B1C0DE", false},
+		{"uuid7", "01890a5d-ac96-774b-bcce-b302099a8057", true},
+		{"uuid7", "9b2f6c1e-3f4a-4d2b-8c7e-1a2b3c4d5e6f", false},
+		{"cuid2", "tz4a98xxat96iws9zmbrgj3a", true},
+		{"cuid2", "Not A Cuid", false},
+		{"nanoid", "V1StGXR8_Z5jdHi6B-myT", true},
+		{"nanoid", "V1StGXR8_Z5jdHi6B-my", false},
+		{"snowflake", "1541815603606036480", true},
+		{"snowflake", "-1", false},
+		{"snowflake", "", false},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.valid, utils.ValidID(tt.tag, tt.id), "%s %q", tt.tag, tt.id)
+	}
+}
@@ -0,0 +1,61 @@
+package utils
+
+import (
+	"encoding/json"
+	"slices"
+
+	"github.com/theCompanyDream/id-trials/apps/backend/models/stats"
+)
+
+type planNode struct {
+	NodeType         string     `json:"Node Type"`
+	RelationName     string     `json:"Relation Name"`
+	SharedHitBlocks  int64      `json:"Shared Hit Blocks"`
+	SharedReadBlocks int64      `json:"Shared Read Blocks"`
+	Plans            []planNode `json:"Plans"`
+}
+
+type explainOutput struct {
+	Plan          planNode `json:"Plan"`
+	PlanningTime  float64  `json:"Planning Time"`
+	ExecutionTime float64  `json:"Execution Time"`
+}
+
+// SummarizePlan condenses the output of EXPLAIN (ANALYZE, BUFFERS, FORMAT JSON).
+// Buffer counts on the root node already include those of its children.
+func SummarizePlan(raw string) (stats.PlanSummary, error) {
+	var outputs []explainOutput
+	if err := json.Unmarshal([]byte(raw), &outputs); err != nil {
+		return stats.PlanSummary{}, err
+	}
+	if len(outputs) == 0 {
+		return stats.PlanSummary{}, nil
+	}
+
+	output := outputs[0]
+	summary := stats.PlanSummary{
+		RootNode:         output.Plan.NodeType,
+		SharedHitBlocks:  output.Plan.SharedHitBlocks,
+		SharedReadBlocks: output.Plan.SharedReadBlocks,
+		PlanningTime:     output.PlanningTime,
+		ExecutionTime:    output.ExecutionTime,
+	}
+	walkPlan(output.Plan, &summary)
+
+	return summary, nil
+}
+
+func walkPlan(node planNode, summary *stats.PlanSummary) {
+	switch node.NodeType {
+	case "Index Scan", "Index Only Scan", "Bitmap Index Scan":
+		summary.UsesIndexScan = true
+	case "Seq Scan":
+		summary.UsesSeqScan = true
+	}
+	if node.RelationName != "" && !slices.Contains(summary.Relations, node.RelationName) {
+		summary.Relations = append(summary.Relations, node.RelationName)
+	}
+	for _, child := range node.Plans {
+		walkPlan(child, summary)
+	}
+}
@@ -0,0 +1,46 @@
+package utils
+
+import (
+	"encoding/json"
+	"errors"
+)
+
+// ErrPatchNotObject is returned when a merge patch is not a JSON object.
+var ErrPatchNotObject = errors.New("merge patch must be a JSON object")
+
+// MergePatch applies an RFC 7396 JSON Merge Patch to document. Members set to
+// null in the patch are removed, objects are merged recursively and every
+// other value replaces the member of the same name.
+func MergePatch(document, patch []byte) ([]byte, error) {
+	var target, changes interface{}
+	if err := json.Unmarshal(document, &target); err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(patch, &changes); err != nil {
+		return nil, err
+	}
+	if _, ok := changes.(map[string]interface{}); !ok {
+		return nil, ErrPatchNotObject
+	}
+	return json.Marshal(mergeValue(target, changes))
+}
+
+func mergeValue(target, patch interface{}) interface{} {
+	patchObject, ok := patch.(map[string]interface{})
+	if !ok {
+		return patch
+	}
+
+	targetObject, ok := target.(map[string]interface{})
+	if !ok {
+		targetObject = make(map[string]interface{}, len(patchObject))
+	}
+	for key, value := range patchObject {
+		if value == nil {
+			delete(targetObject, key)
+		} else {
+			targetObject[key] = mergeValue(targetObject[key], value)
+		}
+	}
+	return targetObject
+}
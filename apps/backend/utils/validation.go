@@ -0,0 +1,63 @@
+package utils
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	"github.com/nrednav/cuid2"
+	"github.com/oklog/ulid/v2"
+	"github.com/segmentio/ksuid"
+)
+
+// nanoIdPattern matches IDs from gonanoid.New: 21 characters of the URL-safe alphabet.
+var nanoIdPattern = regexp.MustCompile(`^[A-Za-z0-9_-]{21}$`)
+
+// idValidators maps each ID format to the validator tag that checks it.
+var idValidators = map[string]validator.Func{
+	"ulid": func(fl validator.FieldLevel) bool {
+		_, err := ulid.ParseStrict(fl.Field().String())
+		return err == nil
+	},
+	"ksuid": func(fl validator.FieldLevel) bool {
+		_, err := ksuid.Parse(fl.Field().String())
+		return err == nil
+	},
+	"cuid2": func(fl validator.FieldLevel) bool {
+		return cuid2.IsCuid(fl.Field().String())
+	},
+	"nanoid": func(fl validator.FieldLevel) bool {
+		return nanoIdPattern.MatchString(fl.Field().String())
+	},
+	"snowflake": func(fl validator.FieldLevel) bool {
+		id, err := strconv.ParseInt(fl.Field().String(), 10, 64)
+		return err == nil && id > 0
+	},
+	"uuid7": func(fl validator.FieldLevel) bool {
+		id, err := uuid.Parse(fl.Field().String())
+		return err == nil && id.Version() == 7
+	},
+}
+
+// NewValidator returns a validator with the ID format tags of idValidators
+// registered next to the built-in ones.
+func NewValidator() *validator.Validate {
+	v := validator.New(validator.WithRequiredStructEnabled())
+	for tag, fn := range idValidators {
+		if err := v.RegisterValidation(tag, fn); err != nil {
+			panic(fmt.Sprintf("failed to register %s validator: %v", tag, err))
+		}
+	}
+	return v
+}
+
+var idValidator = NewValidator()
+
+// ValidID reports whether id is in the format of the validator tag, e.g. "ulid",
+// "snowflake" or the built-in "uuid4", so the routes and the import command
+// check IDs the same way.
+func ValidID(tag, id string) bool {
+	return idValidator.Var(id, "required,"+tag) == nil
+}